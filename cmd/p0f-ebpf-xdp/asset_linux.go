@@ -2,7 +2,12 @@
 
 package main
 
-import _ "embed"
+import "embed"
 
-//go:embed ebpf/xdp_syn.o
-var xdpObj []byte
+// assets holds ebpf/xdp_syn.o when make build-xdp has put it there; the
+// directory always exists so that the build does not depend on it.
+//
+//go:embed ebpf
+var assets embed.FS
+
+var xdpObj, _ = assets.ReadFile("ebpf/xdp_syn.o")
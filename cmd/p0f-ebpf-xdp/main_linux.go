@@ -27,37 +27,13 @@ import (
 	"golang.org/x/sys/unix"
 )
 
-type event struct {
-	TTL   uint8
-	Win   uint16
-	MSS   uint16
-	Opts  uint32
-	ECN   uint8
-	Sip   uint32
-	Dip   uint32
-	Sport uint16
-	Dport uint16
-} // packed in C
+// xdpCaptureSynAck mirrors CFG_SYNACK in ebpf/xdp_syn.c.
+const xdpCaptureSynAck = 1
 
-func optsToSlice(mask uint32) []string {
-	var o []string
-	if mask&(1<<4) != 0 {
-		o = append(o, "nop")
-	}
-	if mask&(1<<0) != 0 {
-		o = append(o, "mss")
-	}
-	if mask&(1<<1) != 0 {
-		o = append(o, "ws")
-	}
-	if mask&(1<<2) != 0 {
-		o = append(o, "sok")
-	}
-	if mask&(1<<3) != 0 {
-		o = append(o, "ts")
-	}
-	return o
-}
+type event struct {
+	CapLen uint16
+	PktLen uint16
+} // packed in C, followed by CapLen bytes of the frame
 
 func main() {
 	var iface string
@@ -69,17 +45,69 @@ func main() {
 	var dstFilter string
 	var metrics bool
 	var metricsAddr string
+	var synack bool
+	var explain bool
+	var alternatives int
+	var minConfidence float64
+	var profile string
+	var fpPath string
+	var fpWatch time.Duration
 	flag.StringVar(&iface, "iface", "", "net interface")
 	flag.BoolVar(&jsonOut, "json", false, "json output")
 	flag.IntVar(&rate, "rate", 0, "max events per second")
 	flag.Float64Var(&sample, "sample", 1.0, "sampling ratio 0..1")
-	flag.IntVar(&sport, "sport", 0, "source tcp port filter")
-	flag.IntVar(&dport, "dport", 0, "destination tcp port filter")
+	flag.IntVar(&sport, "sport", 0, "client tcp port filter (the destination port of a SYN+ACK)")
+	flag.IntVar(&dport, "dport", 0, "service tcp port filter (the source port of a SYN+ACK)")
 	flag.StringVar(&srcFilter, "src", "", "exclude source ip (host or CIDR)")
 	flag.StringVar(&dstFilter, "dst", "", "exclude destination ip (host or CIDR)")
 	flag.BoolVar(&metrics, "metrics", false, "enable /metrics")
 	flag.StringVar(&metricsAddr, "metrics.addr", ":9100", "metrics listen addr")
+	flag.BoolVar(&synack, "synack", false, "also fingerprint servers from SYN+ACK")
+	flag.BoolVar(&explain, "explain", false, "show the per-field score breakdown of the top candidate signatures")
+	flag.IntVar(&alternatives, "alternatives", 2, "number of runner-up labels listed in JSON output")
+	flag.Float64Var(&minConfidence, "min-confidence", 0, "report Unknown when the best label's confidence (0..1) is below this")
+	flag.StringVar(&profile, "profile", "fuzzy", "scoring profile: the preset fuzzy or strict (p0f exact matches only), or a profile file")
+	flag.StringVar(&fpPath, "fp", "", "comma-separated p0f.fp files merged in order, later ones overlaying earlier ones (default: built-in)")
+	flag.DurationVar(&fpWatch, "fp.watch", 5*time.Second, "poll -fp for changes at this interval (0 disables; SIGHUP always reloads)")
 	flag.Parse()
+	if alternatives < 0 {
+		fmt.Println("-alternatives must not be negative")
+		return
+	}
+	prof, err := p0f.LoadProfile(profile)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	// Set the profile before -fp is loaded; reloads keep it.
+	opts := p0f.DefaultOptions()
+	opts.Profile = &prof
+	p0f.SetDefault(p0f.NewDetector(&p0f.Data, opts))
+	var reloader *p0f.Reloader
+	if fpPath != "" {
+		reloader = p0f.NewReloader(strings.Split(fpPath, ",")...)
+		if err := reloader.Reload(); err != nil {
+			fmt.Println(err)
+			return
+		}
+		// A failed reload keeps the old database; report it on stderr so it
+		// does not mix with the events.
+		reloader.OnReload = func(err error) {
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "reload:", err)
+			}
+		}
+		if fpWatch > 0 {
+			go reloader.Watch(fpWatch, nil)
+		}
+		hup := make(chan os.Signal, 1)
+		signal.Notify(hup, syscall.SIGHUP)
+		go func() {
+			for range hup {
+				_ = reloader.Reload()
+			}
+		}()
+	}
 	if iface == "" {
 		iface = os.Getenv("IFACE")
 	}
@@ -110,10 +138,8 @@ func main() {
 				return
 			}
 		}
-		srcIPHost = srcIPHost.To4()
-		if srcIPHost == nil {
-			fmt.Println("invalid src filter")
-			return
+		if v4 := srcIPHost.To4(); v4 != nil {
+			srcIPHost = v4
 		}
 	}
 	var dstIPHost net.IP
@@ -134,42 +160,67 @@ func main() {
 				return
 			}
 		}
-		dstIPHost = dstIPHost.To4()
-		if dstIPHost == nil {
-			fmt.Println("invalid dst filter")
-			return
+		if v4 := dstIPHost.To4(); v4 != nil {
+			dstIPHost = v4
 		}
 	}
+	// Upper bounds of the hop distance histogram; the last is p0f's MAX_DIST.
+	distBounds := [...]int{1, 2, 4, 8, 12, 16, 20, 24, 35}
 	type mstate struct {
 		mu            sync.Mutex
 		byLabel       map[string]*int64
+		byLink        map[string]*int64
 		droppedRate   int64
 		droppedSample int64
 		outputErrors  int64
 		rateLimit     int64
 		samplingRatio float64
+		distBuckets   [len(distBounds)]int64
+		distSum       int64
+		distCount     int64
 	}
 	var ms mstate
 	ms.byLabel = make(map[string]*int64)
+	ms.byLink = make(map[string]*int64)
 	ms.rateLimit = int64(rate)
 	ms.samplingRatio = sample
-	incr := func(lbl string) {
+	incrIn := func(m map[string]*int64, key string) {
 		ms.mu.Lock()
-		p, ok := ms.byLabel[lbl]
+		p, ok := m[key]
 		if !ok {
 			var v int64
 			p = &v
-			ms.byLabel[lbl] = p
+			m[key] = p
 		}
 		ms.mu.Unlock()
 		atomic.AddInt64(p, 1)
 	}
+	incr := func(typ, lbl string) { incrIn(ms.byLabel, typ+"\x00"+lbl) }
+	incrLink := func(link string) { incrIn(ms.byLink, link) }
+	observeDist := func(d int) {
+		for i, le := range distBounds {
+			if d <= le {
+				atomic.AddInt64(&ms.distBuckets[i], 1)
+			}
+		}
+		atomic.AddInt64(&ms.distSum, int64(d))
+		atomic.AddInt64(&ms.distCount, 1)
+	}
 	if metrics {
 		http.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
 			var b strings.Builder
 			ms.mu.Lock()
 			for k, p := range ms.byLabel {
-				b.WriteString("p0f_events_total{label=\"")
+				typ, lbl, _ := strings.Cut(k, "\x00")
+				b.WriteString("p0f_events_total{type=\"")
+				b.WriteString(typ)
+				b.WriteString("\",label=\"")
+				b.WriteString(lbl)
+				b.WriteString("\"} ")
+				b.WriteString(fmt.Sprintf("%d\n", atomic.LoadInt64(p)))
+			}
+			for k, p := range ms.byLink {
+				b.WriteString("p0f_links_total{link=\"")
 				b.WriteString(k)
 				b.WriteString("\"} ")
 				b.WriteString(fmt.Sprintf("%d\n", atomic.LoadInt64(p)))
@@ -180,6 +231,19 @@ func main() {
 			b.WriteString(fmt.Sprintf("p0f_output_errors_total{type=\"json\"} %d\n", atomic.LoadInt64(&ms.outputErrors)))
 			b.WriteString(fmt.Sprintf("p0f_sampling_ratio %g\n", ms.samplingRatio))
 			b.WriteString(fmt.Sprintf("p0f_rate_limit %d\n", ms.rateLimit))
+			if reloader != nil {
+				ok, failed := reloader.Counts()
+				b.WriteString(fmt.Sprintf("p0f_db_reloads_total{result=\"success\"} %d\n", ok))
+				b.WriteString(fmt.Sprintf("p0f_db_reloads_total{result=\"failure\"} %d\n", failed))
+			}
+			count := atomic.LoadInt64(&ms.distCount)
+			b.WriteString("# TYPE p0f_distance_hops histogram\n")
+			for i, le := range distBounds {
+				b.WriteString(fmt.Sprintf("p0f_distance_hops_bucket{le=\"%d\"} %d\n", le, atomic.LoadInt64(&ms.distBuckets[i])))
+			}
+			b.WriteString(fmt.Sprintf("p0f_distance_hops_bucket{le=\"+Inf\"} %d\n", count))
+			b.WriteString(fmt.Sprintf("p0f_distance_hops_sum %d\n", atomic.LoadInt64(&ms.distSum)))
+			b.WriteString(fmt.Sprintf("p0f_distance_hops_count %d\n", count))
 			w.Header().Set("Content-Type", "text/plain; version=0.0.4")
 			_, _ = w.Write([]byte(b.String()))
 		})
@@ -194,7 +258,12 @@ func main() {
 		r := bytes.NewReader(xdpObj)
 		spec, err = ebpf.LoadCollectionSpecFromReader(r)
 	} else {
-		err = fmt.Errorf("xdp object not found")
+		err = fmt.Errorf("xdp object not found: build it with make build-xdp")
+	}
+	if err == nil && spec.Maps["config"] == nil {
+		// Objects built before the config map also predate the current
+		// event layout and would be decoded as garbage.
+		err = fmt.Errorf("xdp object is older than ebpf/xdp_syn.c: rebuild it with make build-xdp")
 	}
 	if err != nil {
 		fmt.Println(err)
@@ -206,6 +275,17 @@ func main() {
 		return
 	}
 	defer coll.Close()
+	if synack {
+		cfg := coll.Maps["config"]
+		if cfg == nil {
+			fmt.Println("config map not found")
+			return
+		}
+		if err := cfg.Put(uint32(0), uint32(xdpCaptureSynAck)); err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
 	prog := coll.Programs["xdp_main"]
 	if prog == nil {
 		fmt.Println("program not found")
@@ -246,56 +326,48 @@ func main() {
 			continue
 		}
 		var ev event
-		if len(rec.RawSample) < 22 {
+		if len(rec.RawSample) < 4 {
+			continue
+		}
+		ev.CapLen = binary.LittleEndian.Uint16(rec.RawSample[0:2])
+		ev.PktLen = binary.LittleEndian.Uint16(rec.RawSample[2:4])
+		frame := rec.RawSample[4:]
+		if int(ev.CapLen) > len(frame) || ev.CapLen < 14 {
 			continue
 		}
-		ev.TTL = rec.RawSample[0]
-		ev.Win = binary.LittleEndian.Uint16(rec.RawSample[1:3])
-		ev.MSS = binary.LittleEndian.Uint16(rec.RawSample[3:5])
-		ev.Opts = binary.LittleEndian.Uint32(rec.RawSample[5:9])
-		ev.ECN = rec.RawSample[9]
-		ev.Sip = binary.LittleEndian.Uint32(rec.RawSample[10:14])
-		ev.Dip = binary.LittleEndian.Uint32(rec.RawSample[14:18])
-		ev.Sport = binary.LittleEndian.Uint16(rec.RawSample[18:20])
-		ev.Dport = binary.LittleEndian.Uint16(rec.RawSample[20:22])
-		if sport > 0 && int(ev.Sport) != sport {
+		frame = frame[:ev.CapLen]
+		if et := binary.BigEndian.Uint16(frame[12:14]); et != 0x0800 && et != 0x86dd {
 			continue
 		}
-		if dport > 0 && int(ev.Dport) != dport {
+		pkt, ok := p0f.ParseIP(frame[14:])
+		if !ok {
+			continue
+		}
+		if sport > 0 && pkt.ClientPort() != sport {
+			continue
+		}
+		if dport > 0 && pkt.ServicePort() != dport {
 			continue
 		}
 		if srcIPHost != nil {
-			sipB := make([]byte, 4)
-			binary.BigEndian.PutUint32(sipB, ev.Sip)
-			ip := net.IP(sipB)
 			if srcIPNet != nil {
-				if srcIPNet.Contains(ip) {
+				if srcIPNet.Contains(pkt.SrcIP) {
 					continue
 				}
-			} else if ip.Equal(srcIPHost) {
+			} else if pkt.SrcIP.Equal(srcIPHost) {
 				continue
 			}
 		}
 		if dstIPHost != nil {
-			dipB := make([]byte, 4)
-			binary.BigEndian.PutUint32(dipB, ev.Dip)
-			ip := net.IP(dipB)
 			if dstIPNet != nil {
-				if dstIPNet.Contains(ip) {
+				if dstIPNet.Contains(pkt.DstIP) {
 					continue
 				}
-			} else if ip.Equal(dstIPHost) {
+			} else if pkt.DstIP.Equal(dstIPHost) {
 				continue
 			}
 		}
-		meta := p0f.PacketMeta{
-			TTL:     int(ev.TTL),
-			Win:     ev.Win,
-			MSS:     ev.MSS,
-			WScale:  0,
-			Options: optsToSlice(ev.Opts),
-			ECN:     ev.ECN != 0,
-		}
+		meta := pkt.Meta
 		if sample < 1.0 && rand.Float64() >= sample {
 			atomic.AddInt64(&ms.droppedSample, 1)
 			continue
@@ -312,26 +384,67 @@ func main() {
 			}
 			count++
 		}
-		lbl := p0f.Detect(meta)
-		sipB := make([]byte, 4)
-		dipB := make([]byte, 4)
-		binary.BigEndian.PutUint32(sipB, ev.Sip)
-		binary.BigEndian.PutUint32(dipB, ev.Dip)
-		src := net.IP(sipB).String()
-		dst := net.IP(dipB).String()
+		evType := "syn"
+		var res p0f.Result
+		var alts []p0f.Result
+		var cands []p0f.Candidate
+		detect, detectTopN, explainOf := p0f.Detect, p0f.DetectTopN, p0f.Explain
+		if meta.Flags&p0f.TCPAck != 0 {
+			evType = "synack"
+			detect, detectTopN, explainOf = p0f.DetectServer, p0f.DetectServerTopN, p0f.ExplainServer
+		}
+		// Only confidence and alternatives need the ranked labels; the plain
+		// label comes from the much cheaper Detect.
+		if jsonOut || minConfidence > 0 {
+			top := detectTopN(meta, 1+alternatives)
+			res, alts = top[0], top[1:]
+			if res.Label != "Unknown" && res.Confidence < minConfidence {
+				// Below -min-confidence report Unknown and list every candidate
+				// as an alternative.
+				alts = top
+				res = p0f.Result{Label: "Unknown", Distance: res.Distance, Confidence: res.Confidence}
+			}
+		} else {
+			res = detect(meta)
+		}
+		if explain {
+			cands = explainOf(meta)
+		}
+		link := p0f.DetectLink(meta.MSS, meta.Version)
+		src := pkt.SrcIP.String()
+		dst := pkt.DstIP.String()
 		if jsonOut {
 			out := struct {
-				Label   string   `json:"label"`
-				TTL     int      `json:"ttl"`
-				Win     uint16   `json:"win"`
-				MSS     uint16   `json:"mss"`
-				Options []string `json:"options"`
-				ECN     bool     `json:"ecn"`
-				SrcIP   string   `json:"src_ip"`
-				DstIP   string   `json:"dst_ip"`
-				SrcPort int      `json:"src_port"`
-				DstPort int      `json:"dst_port"`
-			}{Label: lbl, TTL: meta.TTL, Win: meta.Win, MSS: meta.MSS, Options: meta.Options, ECN: meta.ECN, SrcIP: src, DstIP: dst, SrcPort: int(ev.Sport), DstPort: int(ev.Dport)}
+				Type         string            `json:"type"`
+				Label        string            `json:"label"`
+				Generic      bool              `json:"generic"`
+				Class        string            `json:"class"`
+				Name         string            `json:"name"`
+				Flavor       string            `json:"flavor"`
+				Sig          string            `json:"sig"`
+				RawSig       string            `json:"raw_sig"`
+				Score        float64           `json:"score"`
+				Fuzzy        bool              `json:"fuzzy"`
+				Match        p0f.MatchKind     `json:"match"`
+				Confidence   float64           `json:"confidence"`
+				Distance     int               `json:"dist"`
+				Link         string            `json:"link"`
+				TTL          int               `json:"ttl"`
+				Win          uint16            `json:"win"`
+				MSS          uint16            `json:"mss"`
+				Options      []string          `json:"options"`
+				Version      int               `json:"ip_version"`
+				ECN          bool              `json:"ecn"`
+				Quirks       string            `json:"quirks"`
+				IPOptLen     int               `json:"ip_olen"`
+				PayloadLen   int               `json:"payload_len"`
+				SrcIP        string            `json:"src_ip"`
+				DstIP        string            `json:"dst_ip"`
+				SrcPort      int               `json:"src_port"`
+				DstPort      int               `json:"dst_port"`
+				Alternatives []p0f.Alternative `json:"alternatives,omitempty"`
+				Explain      []p0f.Candidate   `json:"explain,omitempty"`
+			}{Type: evType, Label: res.Label, Generic: res.Generic, Class: res.Class, Name: res.Name, Flavor: res.Flavor, Sig: res.Sig, RawSig: meta.RawSig(), Score: res.Score, Fuzzy: res.Fuzzy, Match: res.Match, Confidence: res.Confidence, Distance: res.Distance, Link: link, TTL: meta.TTL, Win: meta.Win, MSS: meta.MSS, Options: meta.Options, Version: meta.Version, ECN: meta.ECN, Quirks: meta.Quirks.String(), IPOptLen: meta.IPOptLen, PayloadLen: meta.PayloadLen, SrcIP: src, DstIP: dst, SrcPort: pkt.SrcPort, DstPort: pkt.DstPort, Alternatives: p0f.Alternatives(alts), Explain: cands}
 			b, err := json.Marshal(out)
 			if err != nil {
 				atomic.AddInt64(&ms.outputErrors, 1)
@@ -339,8 +452,19 @@ func main() {
 				fmt.Println(string(b))
 			}
 		} else {
-			fmt.Printf("%s src=%s:%d dst=%s:%d\n", lbl, src, ev.Sport, dst, ev.Dport)
+			lbl := res.Label
+			if evType != "syn" {
+				lbl = evType + " " + lbl
+			}
+			fmt.Printf("%s src=%s:%d dst=%s:%d\n", lbl, src, pkt.SrcPort, dst, pkt.DstPort)
+		}
+		if explain && !jsonOut {
+			p0f.WriteExplain(os.Stdout, cands)
+		}
+		incr(evType, res.Label)
+		observeDist(res.Distance)
+		if link != "" {
+			incrLink(link)
 		}
-		incr(lbl)
 	}
 }
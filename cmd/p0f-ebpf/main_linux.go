@@ -11,6 +11,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -22,6 +23,16 @@ import (
 
 func htons(v uint16) uint16 { return (v<<8)&0xff00 | v>>8 }
 
+// flow identifies one direction of a TCP connection.
+type flow struct {
+	src, dst     string
+	sport, dport int
+}
+
+func flowOf(p p0f.Packet) flow {
+	return flow{src: p.SrcIP.String(), dst: p.DstIP.String(), sport: p.SrcPort, dport: p.DstPort}
+}
+
 func main() {
 	var iface string
 	var jsonOut bool
@@ -32,6 +43,14 @@ func main() {
 	var dstFilter string
 	var metrics bool
 	var metricsAddr string
+	var synack bool
+	var explain bool
+	var alternatives int
+	var minConfidence float64
+	var profile string
+	var fpPath string
+	var fpWatch time.Duration
+	var httpOn bool
 	flag.StringVar(&iface, "iface", "", "net interface")
 	flag.BoolVar(&jsonOut, "json", false, "json output")
 	flag.IntVar(&rate, "rate", 0, "max events per second")
@@ -41,7 +60,53 @@ func main() {
 	flag.StringVar(&dstFilter, "dst", "", "exclude destination ip (host or CIDR)")
 	flag.BoolVar(&metrics, "metrics", false, "enable /metrics")
 	flag.StringVar(&metricsAddr, "metrics.addr", ":9100", "metrics listen addr")
+	flag.BoolVar(&synack, "synack", false, "also fingerprint servers from SYN+ACK")
+	flag.BoolVar(&explain, "explain", false, "show the per-field score breakdown of the top candidate signatures")
+	flag.IntVar(&alternatives, "alternatives", 2, "number of runner-up labels listed in JSON output")
+	flag.Float64Var(&minConfidence, "min-confidence", 0, "report Unknown when the best label's confidence (0..1) is below this")
+	flag.BoolVar(&httpOn, "http", false, "fingerprint the first HTTP/1.x request and response on each flow")
+	flag.StringVar(&profile, "profile", "fuzzy", "scoring profile: the preset fuzzy or strict (p0f exact matches only), or a profile file")
+	flag.StringVar(&fpPath, "fp", "", "comma-separated p0f.fp files merged in order, later ones overlaying earlier ones (default: built-in)")
+	flag.DurationVar(&fpWatch, "fp.watch", 5*time.Second, "poll -fp for changes at this interval (0 disables; SIGHUP always reloads)")
 	flag.Parse()
+	if alternatives < 0 {
+		fmt.Println("-alternatives must not be negative")
+		return
+	}
+	prof, err := p0f.LoadProfile(profile)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	// Set the profile before -fp is loaded; reloads keep it.
+	opts := p0f.DefaultOptions()
+	opts.Profile = &prof
+	p0f.SetDefault(p0f.NewDetector(&p0f.Data, opts))
+	var reloader *p0f.Reloader
+	if fpPath != "" {
+		reloader = p0f.NewReloader(strings.Split(fpPath, ",")...)
+		if err := reloader.Reload(); err != nil {
+			fmt.Println(err)
+			return
+		}
+		// A failed reload keeps the old database; report it on stderr so it
+		// does not mix with the events.
+		reloader.OnReload = func(err error) {
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "reload:", err)
+			}
+		}
+		if fpWatch > 0 {
+			go reloader.Watch(fpWatch, nil)
+		}
+		hup := make(chan os.Signal, 1)
+		signal.Notify(hup, syscall.SIGHUP)
+		go func() {
+			for range hup {
+				_ = reloader.Reload()
+			}
+		}()
+	}
 	if iface == "" {
 		iface = os.Getenv("IFACE")
 	}
@@ -71,10 +136,8 @@ func main() {
 				return
 			}
 		}
-		srcIPHost = srcIPHost.To4()
-		if srcIPHost == nil {
-			fmt.Println("invalid src filter")
-			return
+		if v4 := srcIPHost.To4(); v4 != nil {
+			srcIPHost = v4
 		}
 	}
 	var dstIPHost net.IP
@@ -95,42 +158,67 @@ func main() {
 				return
 			}
 		}
-		dstIPHost = dstIPHost.To4()
-		if dstIPHost == nil {
-			fmt.Println("invalid dst filter")
-			return
+		if v4 := dstIPHost.To4(); v4 != nil {
+			dstIPHost = v4
 		}
 	}
+	// Upper bounds of the hop distance histogram; the last is p0f's MAX_DIST.
+	distBounds := [...]int{1, 2, 4, 8, 12, 16, 20, 24, 35}
 	type mstate struct {
 		mu            sync.Mutex
 		byLabel       map[string]*int64
+		byLink        map[string]*int64
 		droppedRate   int64
 		droppedSample int64
 		outputErrors  int64
 		rateLimit     int64
 		samplingRatio float64
+		distBuckets   [len(distBounds)]int64
+		distSum       int64
+		distCount     int64
 	}
 	var ms mstate
 	ms.byLabel = make(map[string]*int64)
+	ms.byLink = make(map[string]*int64)
 	ms.rateLimit = int64(rate)
 	ms.samplingRatio = sample
-	incr := func(lbl string) {
+	incrIn := func(m map[string]*int64, key string) {
 		ms.mu.Lock()
-		p, ok := ms.byLabel[lbl]
+		p, ok := m[key]
 		if !ok {
 			var v int64
 			p = &v
-			ms.byLabel[lbl] = p
+			m[key] = p
 		}
 		ms.mu.Unlock()
 		atomic.AddInt64(p, 1)
 	}
+	incr := func(typ, lbl string) { incrIn(ms.byLabel, typ+"\x00"+lbl) }
+	incrLink := func(link string) { incrIn(ms.byLink, link) }
+	observeDist := func(d int) {
+		for i, le := range distBounds {
+			if d <= le {
+				atomic.AddInt64(&ms.distBuckets[i], 1)
+			}
+		}
+		atomic.AddInt64(&ms.distSum, int64(d))
+		atomic.AddInt64(&ms.distCount, 1)
+	}
 	if metrics {
 		http.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
 			var b strings.Builder
 			ms.mu.Lock()
 			for k, p := range ms.byLabel {
-				b.WriteString("p0f_events_total{label=\"")
+				typ, lbl, _ := strings.Cut(k, "\x00")
+				b.WriteString("p0f_events_total{type=\"")
+				b.WriteString(typ)
+				b.WriteString("\",label=\"")
+				b.WriteString(lbl)
+				b.WriteString("\"} ")
+				b.WriteString(fmt.Sprintf("%d\n", atomic.LoadInt64(p)))
+			}
+			for k, p := range ms.byLink {
+				b.WriteString("p0f_links_total{link=\"")
 				b.WriteString(k)
 				b.WriteString("\"} ")
 				b.WriteString(fmt.Sprintf("%d\n", atomic.LoadInt64(p)))
@@ -141,6 +229,19 @@ func main() {
 			b.WriteString(fmt.Sprintf("p0f_output_errors_total{type=\"json\"} %d\n", atomic.LoadInt64(&ms.outputErrors)))
 			b.WriteString(fmt.Sprintf("p0f_sampling_ratio %g\n", ms.samplingRatio))
 			b.WriteString(fmt.Sprintf("p0f_rate_limit %d\n", ms.rateLimit))
+			if reloader != nil {
+				ok, failed := reloader.Counts()
+				b.WriteString(fmt.Sprintf("p0f_db_reloads_total{result=\"success\"} %d\n", ok))
+				b.WriteString(fmt.Sprintf("p0f_db_reloads_total{result=\"failure\"} %d\n", failed))
+			}
+			count := atomic.LoadInt64(&ms.distCount)
+			b.WriteString("# TYPE p0f_distance_hops histogram\n")
+			for i, le := range distBounds {
+				b.WriteString(fmt.Sprintf("p0f_distance_hops_bucket{le=\"%d\"} %d\n", le, atomic.LoadInt64(&ms.distBuckets[i])))
+			}
+			b.WriteString(fmt.Sprintf("p0f_distance_hops_bucket{le=\"+Inf\"} %d\n", count))
+			b.WriteString(fmt.Sprintf("p0f_distance_hops_sum %d\n", atomic.LoadInt64(&ms.distSum)))
+			b.WriteString(fmt.Sprintf("p0f_distance_hops_count %d\n", count))
 			w.Header().Set("Content-Type", "text/plain; version=0.0.4")
 			_, _ = w.Write([]byte(b.String()))
 		})
@@ -148,13 +249,13 @@ func main() {
 			_ = http.ListenAndServe(metricsAddr, nil)
 		}()
 	}
-	fd, err := syscall.Socket(syscall.AF_PACKET, syscall.SOCK_RAW, int(htons(0x0800)))
+	fd, err := syscall.Socket(syscall.AF_PACKET, syscall.SOCK_RAW, int(htons(syscall.ETH_P_ALL)))
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
 	defer syscall.Close(fd)
-	sll := &syscall.SockaddrLinklayer{Protocol: htons(0x0800), Ifindex: i.Index}
+	sll := &syscall.SockaddrLinklayer{Protocol: htons(syscall.ETH_P_ALL), Ifindex: i.Index}
 	if err := syscall.Bind(fd, sll); err != nil {
 		fmt.Println(err)
 		return
@@ -165,31 +266,91 @@ func main() {
 	if sample < 1.0 {
 		rand.Seed(time.Now().UnixNano())
 	}
+	admit := func() bool {
+		if sample < 1.0 && rand.Float64() >= sample {
+			atomic.AddInt64(&ms.droppedSample, 1)
+			return false
+		}
+		if rate > 0 {
+			now := time.Now().Unix()
+			if now != ts {
+				ts = now
+				count = 0
+			}
+			if count >= rate {
+				atomic.AddInt64(&ms.droppedRate, 1)
+				return false
+			}
+			count++
+		}
+		return true
+	}
+	// The SYN result of each flow, so the OS an HTTP User-Agent claims can
+	// be checked against the TCP fingerprint.
+	const maxFlows = 65536
+	tracker := p0f.NewHTTPTracker(maxFlows)
+	synOS := make(map[flow]p0f.Result)
+	emitHTTP := func(pkt p0f.Packet, h p0f.HTTPMeta) {
+		evType := "http_request"
+		var res p0f.HTTPResult
+		mismatch := false
+		if h.Response {
+			evType = "http_response"
+			res = p0f.DetectHTTPServer(h)
+		} else {
+			res = p0f.DetectHTTP(h)
+			k := flowOf(pkt)
+			tcp, seen := synOS[k]
+			delete(synOS, k)
+			mismatch = seen && res.OSMismatch(tcp)
+		}
+		if jsonOut {
+			out := struct {
+				Type        string `json:"type"`
+				Label       string `json:"label"`
+				Generic     bool   `json:"generic"`
+				Class       string `json:"class"`
+				Name        string `json:"name"`
+				Flavor      string `json:"flavor"`
+				Sig         string `json:"sig"`
+				RawSig      string `json:"raw_sig"`
+				Dishonest   bool   `json:"dishonest"`
+				OSMismatch  bool   `json:"os_mismatch"`
+				UA          string `json:"ua"`
+				UAOS        string `json:"ua_os"`
+				HTTPVersion int    `json:"http_version"`
+				Status      int    `json:"http_status,omitempty"`
+				SrcIP       string `json:"src_ip"`
+				DstIP       string `json:"dst_ip"`
+				SrcPort     int    `json:"src_port"`
+				DstPort     int    `json:"dst_port"`
+			}{Type: evType, Label: res.Label, Generic: res.Generic, Class: res.Class, Name: res.Name, Flavor: res.Flavor, Sig: res.Sig, RawSig: h.RawSig(), Dishonest: res.Dishonest, OSMismatch: mismatch, UA: res.UA, UAOS: res.UAOS, HTTPVersion: h.Version, Status: h.Status, SrcIP: pkt.SrcIP.String(), DstIP: pkt.DstIP.String(), SrcPort: pkt.SrcPort, DstPort: pkt.DstPort}
+			b, err := json.Marshal(out)
+			if err != nil {
+				atomic.AddInt64(&ms.outputErrors, 1)
+			} else {
+				fmt.Println(string(b))
+			}
+		} else {
+			fmt.Println(evType, res.Label)
+		}
+		incr(evType, res.Label)
+	}
 	for {
 		n, err := syscall.Read(fd, buf)
 		if err != nil || n < 54 {
 			continue
 		}
 		eth := buf[:14]
-		if binary.BigEndian.Uint16(eth[12:14]) != 0x0800 {
-			continue
-		}
-		ip := buf[14:]
-		if len(ip) < 20 {
-			continue
-		}
-		if ip[0]>>4 != 4 {
+		if et := binary.BigEndian.Uint16(eth[12:14]); et != 0x0800 && et != 0x86dd {
 			continue
 		}
-		ihl := int(ip[0]&0x0f) * 4
-		if len(ip) < ihl {
-			continue
-		}
-		if ip[9] != 6 {
+		pkt, ok := p0f.ParseIP(buf[14:n])
+		if !ok {
 			continue
 		}
-		srcIP := net.IP(ip[12:16])
-		dstIP := net.IP(ip[16:20])
+		srcIP := pkt.SrcIP
+		dstIP := pkt.DstIP
 		if srcIPHost != nil {
 			if srcIPNet != nil {
 				if srcIPNet.Contains(srcIP) {
@@ -208,73 +369,117 @@ func main() {
 				continue
 			}
 		}
-		ttl := int(ip[8])
-		tcp := ip[ihl:]
-		if len(tcp) < 20 {
-			continue
-		}
-		if dport > 0 {
-			dp := int(binary.BigEndian.Uint16(tcp[2:4]))
-			if dp != dport {
+		flags := pkt.Meta.Flags
+		if flags&p0f.TCPSyn == 0 {
+			if !httpOn || dport > 0 && pkt.DstPort != dport && pkt.SrcPort != dport {
 				continue
 			}
+			h, ok := tracker.Feed(pkt)
+			if !ok || !admit() {
+				continue
+			}
+			emitHTTP(pkt, h)
+			continue
 		}
-		dataOffset := int((tcp[12] >> 4) * 4)
-		if len(tcp) < dataOffset {
+		server := flags&p0f.TCPAck != 0
+		if server && !synack {
 			continue
 		}
-		flags := tcp[13]
-		if flags&0x02 == 0 || flags&0x10 != 0 {
+		if dport > 0 && pkt.ServicePort() != dport {
 			continue
 		}
-		if sample < 1.0 && rand.Float64() >= sample {
-			atomic.AddInt64(&ms.droppedSample, 1)
+		if !admit() {
 			continue
 		}
-		if rate > 0 {
-			now := time.Now().Unix()
-			if now != ts {
-				ts = now
-				count = 0
-			}
-			if count >= rate {
-				atomic.AddInt64(&ms.droppedRate, 1)
-				continue
+		meta := pkt.Meta
+		evType := "syn"
+		var res p0f.Result
+		var alts []p0f.Result
+		var cands []p0f.Candidate
+		detect, detectTopN, explainOf := p0f.Detect, p0f.DetectTopN, p0f.Explain
+		if meta.Flags&p0f.TCPAck != 0 {
+			evType = "synack"
+			detect, detectTopN, explainOf = p0f.DetectServer, p0f.DetectServerTopN, p0f.ExplainServer
+		}
+		// Only confidence and alternatives need the ranked labels; the plain
+		// label comes from the much cheaper Detect.
+		if jsonOut || minConfidence > 0 {
+			top := detectTopN(meta, 1+alternatives)
+			res, alts = top[0], top[1:]
+			if res.Label != "Unknown" && res.Confidence < minConfidence {
+				// Below -min-confidence report Unknown and list every candidate
+				// as an alternative.
+				alts = top
+				res = p0f.Result{Label: "Unknown", Distance: res.Distance, Confidence: res.Confidence}
 			}
-			count++
+		} else {
+			res = detect(meta)
 		}
-		win := binary.BigEndian.Uint16(tcp[14:16])
-		opts := tcp[20:dataOffset]
-		o, mss, wscale := p0f.ParseTCPOptions(opts)
-		meta := p0f.PacketMeta{TTL: ttl, Win: win, MSS: mss, WScale: wscale, Options: o, ECN: flags&0x40 != 0}
-		lbl := p0f.Detect(meta)
+		if explain {
+			cands = explainOf(meta)
+		}
+		link := p0f.DetectLink(meta.MSS, meta.Version)
 		if jsonOut {
 			srcIPStr := srcIP.String()
 			dstIPStr := dstIP.String()
-			srcPort := int(binary.BigEndian.Uint16(tcp[0:2]))
-			dstPort := int(binary.BigEndian.Uint16(tcp[2:4]))
+			srcPort := pkt.SrcPort
+			dstPort := pkt.DstPort
 			out := struct {
-				Label   string   `json:"label"`
-				TTL     int      `json:"ttl"`
-				Win     uint16   `json:"win"`
-				MSS     uint16   `json:"mss"`
-				Options []string `json:"options"`
-				ECN     bool     `json:"ecn"`
-				DPort   int      `json:"dport"`
-				SrcIP   string   `json:"src_ip"`
-				DstIP   string   `json:"dst_ip"`
-				SrcPort int      `json:"src_port"`
-				DstPort int      `json:"dst_port"`
-			}{Label: lbl, TTL: meta.TTL, Win: meta.Win, MSS: meta.MSS, Options: meta.Options, ECN: meta.ECN, DPort: dstPort, SrcIP: srcIPStr, DstIP: dstIPStr, SrcPort: srcPort, DstPort: dstPort}
+				Type         string            `json:"type"`
+				Label        string            `json:"label"`
+				Generic      bool              `json:"generic"`
+				Class        string            `json:"class"`
+				Name         string            `json:"name"`
+				Flavor       string            `json:"flavor"`
+				Sig          string            `json:"sig"`
+				RawSig       string            `json:"raw_sig"`
+				Score        float64           `json:"score"`
+				Fuzzy        bool              `json:"fuzzy"`
+				Match        p0f.MatchKind     `json:"match"`
+				Confidence   float64           `json:"confidence"`
+				Distance     int               `json:"dist"`
+				Link         string            `json:"link"`
+				TTL          int               `json:"ttl"`
+				Win          uint16            `json:"win"`
+				MSS          uint16            `json:"mss"`
+				Options      []string          `json:"options"`
+				Version      int               `json:"ip_version"`
+				ECN          bool              `json:"ecn"`
+				Quirks       string            `json:"quirks"`
+				IPOptLen     int               `json:"ip_olen"`
+				PayloadLen   int               `json:"payload_len"`
+				DPort        int               `json:"dport"`
+				SrcIP        string            `json:"src_ip"`
+				DstIP        string            `json:"dst_ip"`
+				SrcPort      int               `json:"src_port"`
+				DstPort      int               `json:"dst_port"`
+				Alternatives []p0f.Alternative `json:"alternatives,omitempty"`
+				Explain      []p0f.Candidate   `json:"explain,omitempty"`
+			}{Type: evType, Label: res.Label, Generic: res.Generic, Class: res.Class, Name: res.Name, Flavor: res.Flavor, Sig: res.Sig, RawSig: meta.RawSig(), Score: res.Score, Fuzzy: res.Fuzzy, Match: res.Match, Confidence: res.Confidence, Distance: res.Distance, Link: link, TTL: meta.TTL, Win: meta.Win, MSS: meta.MSS, Options: meta.Options, Version: meta.Version, ECN: meta.ECN, Quirks: meta.Quirks.String(), IPOptLen: meta.IPOptLen, PayloadLen: meta.PayloadLen, DPort: dstPort, SrcIP: srcIPStr, DstIP: dstIPStr, SrcPort: srcPort, DstPort: dstPort, Alternatives: p0f.Alternatives(alts), Explain: cands}
 			b, err := json.Marshal(out)
 			if err != nil {
 				atomic.AddInt64(&ms.outputErrors, 1)
 			} else {
 				fmt.Println(string(b))
 			}
+		} else if server {
+			fmt.Println(evType, res.Label)
 		} else {
-			fmt.Println(lbl)
+			fmt.Println(res.Label)
+		}
+		if explain && !jsonOut {
+			p0f.WriteExplain(os.Stdout, cands)
+		}
+		incr(evType, res.Label)
+		observeDist(res.Distance)
+		if httpOn && !server {
+			if len(synOS) >= maxFlows {
+				synOS = make(map[flow]p0f.Result)
+			}
+			synOS[flowOf(pkt)] = res
+		}
+		if link != "" {
+			incrLink(link)
 		}
-		incr(lbl)
 	}
 }
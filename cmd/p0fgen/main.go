@@ -1,101 +1,185 @@
 package main
 
 import (
-	"bufio"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
-)
 
-type entry struct {
-	section string
-	label   string
-	sys     string
-	sigs    []string
-}
+	"github.com/sim0nj/p0f2go/p0f"
+)
 
-func parse(fpPath string) ([]entry, error) {
-	f, err := os.Open(fpPath)
-	if err != nil {
-		return nil, err
-	}
-	defer f.Close()
-	var entries []entry
-	var cur entry
-	var section string
-	sc := bufio.NewScanner(f)
-	for sc.Scan() {
-		line := sc.Text()
-		t := strings.TrimSpace(line)
-		if t == "" {
-			continue
+func writeDataGo(outPath string, db *p0f.DB) error {
+	var b strings.Builder
+	b.WriteString("package p0f\n\n")
+	b.WriteString("var Data = DB{\n")
+	b.WriteString("Classes: ")
+	writeStrings(&b, db.Classes)
+	b.WriteString(",\n")
+	b.WriteString("UAOS: []UAMapping{")
+	for i, m := range db.UAOS {
+		if i > 0 {
+			b.WriteString(",")
 		}
-		if strings.HasPrefix(t, ";") {
-			continue
+		b.WriteString(fmt.Sprintf("{OS: %q, Match: %q}", m.OS, m.Match))
+	}
+	b.WriteString("},\n")
+	b.WriteString("Entries: []Entry{\n")
+	for _, e := range db.Entries {
+		b.WriteString("{Section: ")
+		b.WriteString(fmt.Sprintf("%q", e.Section))
+		b.WriteString(", Label: ")
+		b.WriteString(fmt.Sprintf("%q", e.Label))
+		if e.Generic {
+			b.WriteString(", Generic: true")
 		}
-		if strings.HasPrefix(t, "[") && strings.HasSuffix(t, "]") {
-			section = strings.TrimSuffix(strings.TrimPrefix(t, "["), "]")
-			continue
+		if e.Name != "" {
+			b.WriteString(fmt.Sprintf(", Class: %q, Name: %q, Flavor: %q", e.Class, e.Name, e.Flavor))
 		}
-		if strings.HasPrefix(t, "label") {
-			if cur.label != "" {
-				entries = append(entries, cur)
-				cur = entry{}
-			}
-			parts := strings.SplitN(t, "=", 2)
-			if len(parts) == 2 {
-				cur.section = section
-				cur.label = strings.TrimSpace(parts[1])
-			}
-			continue
+		b.WriteString(", Sys: ")
+		writeStrings(&b, e.Sys)
+		b.WriteString(", Sig: ")
+		writeStrings(&b, e.Sig)
+		if e.Priority != 0 {
+			b.WriteString(fmt.Sprintf(", Priority: %d", e.Priority))
 		}
-		if strings.HasPrefix(t, "sys") {
-			parts := strings.SplitN(t, "=", 2)
-			if len(parts) == 2 {
-				cur.sys = strings.TrimSpace(parts[1])
+		if e.TCP != nil {
+			b.WriteString(",\nTCP: []Signature{\n")
+			for i := range e.TCP {
+				writeSignature(&b, &e.TCP[i])
 			}
-			continue
+			b.WriteString("}")
 		}
-		if strings.HasPrefix(t, "sig") {
-			parts := strings.SplitN(t, "=", 2)
-			if len(parts) == 2 {
-				cur.sigs = append(cur.sigs, strings.TrimSpace(parts[1]))
+		if e.HTTP != nil {
+			b.WriteString(",\nHTTP: []HTTPSignature{\n")
+			for i := range e.HTTP {
+				writeHTTPSignature(&b, &e.HTTP[i])
 			}
-			continue
+			b.WriteString("}")
 		}
+		b.WriteString("},\n")
 	}
-	if err := sc.Err(); err != nil {
-		return nil, err
+	b.WriteString("}}\n")
+	return os.WriteFile(outPath, []byte(b.String()), 0o644)
+}
+
+var winTypes = map[p0f.WinType]string{
+	p0f.WinAny:   "WinAny",
+	p0f.WinValue: "WinValue",
+	p0f.WinMSS:   "WinMSS",
+	p0f.WinMTU:   "WinMTU",
+	p0f.WinMod:   "WinMod",
+}
+
+var quirkConsts = []struct {
+	q    p0f.Quirks
+	name string
+}{
+	{p0f.QuirkDF, "QuirkDF"},
+	{p0f.QuirkNonZeroID, "QuirkNonZeroID"},
+	{p0f.QuirkZeroID, "QuirkZeroID"},
+	{p0f.QuirkECN, "QuirkECN"},
+	{p0f.QuirkNonZeroReserved, "QuirkNonZeroReserved"},
+	{p0f.QuirkFlow, "QuirkFlow"},
+	{p0f.QuirkZeroSeq, "QuirkZeroSeq"},
+	{p0f.QuirkNonZeroAck, "QuirkNonZeroAck"},
+	{p0f.QuirkZeroAck, "QuirkZeroAck"},
+	{p0f.QuirkNonZeroURG, "QuirkNonZeroURG"},
+	{p0f.QuirkURG, "QuirkURG"},
+	{p0f.QuirkPush, "QuirkPush"},
+	{p0f.QuirkZeroTS1, "QuirkZeroTS1"},
+	{p0f.QuirkNonZeroTS2, "QuirkNonZeroTS2"},
+	{p0f.QuirkOptTrailing, "QuirkOptTrailing"},
+	{p0f.QuirkExcessWS, "QuirkExcessWS"},
+	{p0f.QuirkBadOpt, "QuirkBadOpt"},
+}
+
+// writeSignature writes a parsed tcp signature, keeping the text as Raw
+// for display.
+func writeSignature(b *strings.Builder, sig *p0f.Signature) {
+	b.WriteString(fmt.Sprintf("{Raw: %q, Version: %s, TTL: %d", sig.Raw, wild(sig.Version), sig.TTL))
+	if sig.BadTTL {
+		b.WriteString(", BadTTL: true")
+	}
+	b.WriteString(fmt.Sprintf(", OLen: %d, MSS: %s, WinType: %s, Win: %d, Scale: %s, Layout: ", sig.OLen, wild(sig.MSS), winTypes[sig.WinType], sig.Win, wild(sig.Scale)))
+	writeStrings(b, sig.Layout)
+	b.WriteString(", Quirks: ")
+	var qs []string
+	for _, q := range quirkConsts {
+		if sig.Quirks&q.q != 0 {
+			qs = append(qs, q.name)
+		}
 	}
-	if cur.label != "" {
-		entries = append(entries, cur)
+	if qs == nil {
+		qs = []string{"0"}
 	}
-	return entries, nil
+	b.WriteString(strings.Join(qs, "|"))
+	b.WriteString(fmt.Sprintf(", PClass: %s},\n", wild(sig.PClass)))
 }
 
-func writeDataGo(outPath string, entries []entry) error {
-	var b strings.Builder
-	b.WriteString("package p0f\n\n")
-	b.WriteString("var Data = DB{Entries: []Entry{\n")
-	for _, e := range entries {
-		b.WriteString("{Section: ")
-		b.WriteString(fmt.Sprintf("%q", e.section))
-		b.WriteString(", Label: ")
-		b.WriteString(fmt.Sprintf("%q", e.label))
-		b.WriteString(", Sys: ")
-		b.WriteString(fmt.Sprintf("%q", e.sys))
-		b.WriteString(", Sig: []string{")
-		for i, s := range e.sigs {
+func writeHTTPSignature(b *strings.Builder, sig *p0f.HTTPSignature) {
+	b.WriteString(fmt.Sprintf("{Raw: %q, Version: %s, Order: ", sig.Raw, wild(sig.Version)))
+	if sig.Order == nil {
+		b.WriteString("nil")
+	} else {
+		b.WriteString("[]HTTPSigHeader{")
+		for i, h := range sig.Order {
 			if i > 0 {
 				b.WriteString(",")
 			}
-			b.WriteString(fmt.Sprintf("%q", s))
+			b.WriteString(fmt.Sprintf("{Name: %q", h.Name))
+			if h.HasValue {
+				b.WriteString(fmt.Sprintf(", Value: %q, HasValue: true", h.Value))
+			}
+			if h.Optional {
+				b.WriteString(", Optional: true")
+			}
+			b.WriteString("}")
 		}
-		b.WriteString("}},\n")
+		b.WriteString("}")
 	}
-	b.WriteString("}}\n")
-	return os.WriteFile(outPath, []byte(b.String()), 0o644)
+	b.WriteString(", Absent: ")
+	writeStrings(b, sig.Absent)
+	b.WriteString(fmt.Sprintf(", Software: %q},\n", sig.Software))
+}
+
+func wild(n int) string {
+	if n == p0f.Any {
+		return "Any"
+	}
+	return fmt.Sprint(n)
+}
+
+func writeStrings(b *strings.Builder, list []string) {
+	if list == nil {
+		b.WriteString("nil")
+		return
+	}
+	b.WriteString("[]string{")
+	for i, s := range list {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString(fmt.Sprintf("%q", s))
+	}
+	b.WriteString("}")
+}
+
+// runLint prints file:line diagnostics and returns a nonzero exit code
+// when there are any.
+func runLint(paths []string) int {
+	diags, err := p0f.LintFiles(paths...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	for _, d := range diags {
+		fmt.Fprintln(os.Stderr, d)
+	}
+	if len(diags) > 0 {
+		return 1
+	}
+	return 0
 }
 
 func main() {
@@ -103,13 +187,27 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	fpPath := filepath.Join(root, "p0f.fp")
-	entries, err := parse(fpPath)
+	// The arguments are p0f.fp files merged in order, later ones overriding
+	// earlier ones; p0f.fp alone by default.
+	args := os.Args[1:]
+	lint := len(args) > 0 && args[0] == "lint"
+	if lint {
+		args = args[1:]
+	}
+	paths := args
+	if len(paths) == 0 {
+		paths = []string{filepath.Join(root, "p0f.fp")}
+	}
+	if lint {
+		os.Exit(runLint(paths))
+	}
+	db, err := p0f.LoadDBFiles(paths...)
 	if err != nil {
-		panic(err)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
 	outPath := filepath.Join(root, "p0f", "data.go")
-	if err := writeDataGo(outPath, entries); err != nil {
+	if err := writeDataGo(outPath, db); err != nil {
 		panic(err)
 	}
 }
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/sim0nj/p0f2go/p0f"
+)
+
+// readPcap feeds the SYNs (SYN+ACKs with synack) of a pcap or pcapng file
+// to l and returns how many it used.
+func readPcap(path string, synack bool, l *p0f.Learner) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	br := bufio.NewReader(f)
+	magic, err := br.Peek(4)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %v", path, err)
+	}
+	var src gopacket.PacketDataSource
+	var lt layers.LinkType
+	if binary.LittleEndian.Uint32(magic) == 0x0A0D0D0A {
+		r, err := pcapgo.NewNgReader(br, pcapgo.DefaultNgReaderOptions)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %v", path, err)
+		}
+		src, lt = r, r.LinkType()
+	} else {
+		r, err := pcapgo.NewReader(br)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %v", path, err)
+		}
+		src, lt = r, r.LinkType()
+	}
+	ps := gopacket.NewPacketSource(src, lt)
+	ps.DecodeOptions = gopacket.Lazy
+	n := 0
+	for {
+		pkt, err := ps.NextPacket()
+		if errors.Is(err, io.EOF) {
+			return n, nil
+		}
+		if err != nil {
+			return n, fmt.Errorf("%s: %v", path, err)
+		}
+		nl := pkt.NetworkLayer()
+		if nl == nil {
+			continue
+		}
+		// The network layer is a subslice of pkt.Data(); parse from its start
+		// so the IPv6 extension headers are kept.
+		data := pkt.Data()
+		off := cap(data) - cap(nl.LayerContents())
+		if off < 0 || off > len(data) {
+			continue
+		}
+		parsed, ok := p0f.ParseIP(data[off:])
+		if !ok {
+			continue
+		}
+		flags := parsed.Meta.Flags
+		if flags&p0f.TCPSyn == 0 || (flags&p0f.TCPAck != 0) != synack {
+			continue
+		}
+		l.Add(parsed.Meta)
+		n++
+	}
+}
+
+func main() {
+	var label string
+	var synack bool
+	var prio int
+	flag.StringVar(&label, "label", "", "label for the learned signatures, e.g. s:unix:Linux:our image")
+	flag.BoolVar(&synack, "synack", false, "learn tcp:response signatures from SYN+ACKs instead of SYNs")
+	flag.IntVar(&prio, "prio", 1, "prio of the learned label, so it wins ties with stock signatures (0 omits it)")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: p0flearn -label type:class:name:flavor [-synack] [-prio N] file.pcap...")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if label == "" || flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	var l p0f.Learner
+	total := 0
+	for _, path := range flag.Args() {
+		n, err := readPcap(path, synack, &l)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		total += n
+	}
+	if total == 0 {
+		fmt.Fprintln(os.Stderr, "no matching packets")
+		os.Exit(1)
+	}
+	section, kind := "tcp:request", "SYN"
+	if synack {
+		section, kind = "tcp:response", "SYN+ACK"
+	}
+	// Print a p0f.fp fragment that works as an -fp overlay file.
+	fmt.Printf("; learned from %d %s packets in %s\n", total, kind, strings.Join(flag.Args(), ", "))
+	fmt.Printf("[%s]\n\n", section)
+	fmt.Printf("label = %s\n", label)
+	if prio != 0 {
+		fmt.Printf("prio  = %d\n", prio)
+	}
+	for _, s := range l.Signatures() {
+		fmt.Printf("; %d packets\n", s.Count)
+		fmt.Printf("sig   = %s\n", s.Sig)
+	}
+}
@@ -3,6 +3,7 @@
 #include <bpf/bpf_endian.h>
 #include <linux/if_ether.h>
 #include <linux/ip.h>
+#include <linux/ipv6.h>
 #include <linux/tcp.h>
 #include <linux/in.h>
 
@@ -10,65 +11,97 @@ struct {
 	__uint(type, BPF_MAP_TYPE_PERF_EVENT_ARRAY);
 } events SEC(".maps");
 
+/* config[0] holds CFG_* flags set by userspace. */
+#define CFG_SYNACK 1
+
+struct {
+	__uint(type, BPF_MAP_TYPE_ARRAY);
+	__uint(max_entries, 1);
+	__type(key, __u32);
+	__type(value, __u32);
+} config SEC(".maps");
+
+/* Headers are decoded in userspace: the event is followed by the first
+ * cap_len bytes of the frame. */
+#define MAX_CAPTURE 256
+#define MAX_EXT_HDRS 4
+
 struct event {
-	__u8 ttl;
-	__u16 win;
-	__u16 mss;
-	__u32 opts;
-	__u8 ecn;
-	__u32 sip;
-	__u32 dip;
-	__u16 sport;
-	__u16 dport;
+	__u16 cap_len;
+	__u16 pkt_len;
 } __attribute__((packed));
 
 static __always_inline int parse(struct xdp_md *ctx) {
-	void *pos = (void *)(long)ctx->data;
+	void *data = (void *)(long)ctx->data;
 	void *end = (void *)(long)ctx->data_end;
+	void *pos = data;
 	struct ethhdr *eth = pos;
 	if (pos + sizeof(*eth) > end) return XDP_PASS;
 	pos += sizeof(*eth);
-	if (eth->h_proto != bpf_htons(ETH_P_IP)) return XDP_PASS;
-	struct iphdr iph;
-	if (pos + sizeof(iph) > end) return XDP_PASS;
-	__builtin_memcpy(&iph, pos, sizeof(iph));
-	if (iph.version != 4) return XDP_PASS;
-	if (iph.protocol != IPPROTO_TCP) return XDP_PASS;
-	__u32 ihl = iph.ihl * 4;
-	if (ihl < sizeof(iph)) ihl = sizeof(iph);
-	if ((char *)pos + ihl > (char *)end) return XDP_PASS;
-	pos = (char *)pos + ihl;
+	if (eth->h_proto == bpf_htons(ETH_P_IP)) {
+		struct iphdr iph;
+		if (pos + sizeof(iph) > end) return XDP_PASS;
+		__builtin_memcpy(&iph, pos, sizeof(iph));
+		if (iph.version != 4) return XDP_PASS;
+		if (iph.protocol != IPPROTO_TCP) return XDP_PASS;
+		__u32 ihl = iph.ihl * 4;
+		if (ihl < sizeof(iph)) ihl = sizeof(iph);
+		if ((char *)pos + ihl > (char *)end) return XDP_PASS;
+		pos = (char *)pos + ihl;
+	} else if (eth->h_proto == bpf_htons(ETH_P_IPV6)) {
+		struct ipv6hdr ip6h;
+		if (pos + sizeof(ip6h) > end) return XDP_PASS;
+		__builtin_memcpy(&ip6h, pos, sizeof(ip6h));
+		if (ip6h.version != 6) return XDP_PASS;
+		pos = (char *)pos + sizeof(ip6h);
+		__u8 nexthdr = ip6h.nexthdr;
+#pragma unroll
+		for (int i = 0; i < MAX_EXT_HDRS; i++) {
+			if (nexthdr == IPPROTO_TCP) break;
+			struct ipv6_opt_hdr oh;
+			if (pos + sizeof(oh) > end) return XDP_PASS;
+			__builtin_memcpy(&oh, pos, sizeof(oh));
+			__u32 len;
+			switch (nexthdr) {
+			case IPPROTO_HOPOPTS:
+			case IPPROTO_ROUTING:
+			case IPPROTO_DSTOPTS:
+				len = (oh.hdrlen + 1) * 8;
+				break;
+			case IPPROTO_FRAGMENT:
+				len = 8;
+				break;
+			case IPPROTO_AH:
+				len = (oh.hdrlen + 2) * 4;
+				break;
+			default:
+				return XDP_PASS;
+			}
+			nexthdr = oh.nexthdr;
+			pos = (char *)pos + len;
+		}
+		if (nexthdr != IPPROTO_TCP) return XDP_PASS;
+	} else {
+		return XDP_PASS;
+	}
 	struct tcphdr tcph;
 	if (pos + sizeof(tcph) > end) return XDP_PASS;
 	__builtin_memcpy(&tcph, pos, sizeof(tcph));
-	if (!tcph.syn || tcph.ack) return XDP_PASS;
+	if (!tcph.syn) return XDP_PASS;
+	if (tcph.ack) {
+		__u32 key = 0;
+		__u32 *cfg = bpf_map_lookup_elem(&config, &key);
+		if (!cfg || !(*cfg & CFG_SYNACK)) return XDP_PASS;
+	}
 	__u32 doff = tcph.doff * 4;
 	if (doff < sizeof(tcph)) doff = sizeof(tcph);
 	if ((char *)pos + doff > (char *)end) return XDP_PASS;
 	struct event e = {};
-	e.ttl = iph.ttl;
-	e.win = bpf_ntohs(tcph.window);
-	e.sip = bpf_ntohl(iph.saddr);
-	e.dip = bpf_ntohl(iph.daddr);
-	e.sport = bpf_ntohs(tcph.source);
-	e.dport = bpf_ntohs(tcph.dest);
-	__u8 *opt = (void *)((char *)pos + sizeof(tcph));
-	__u8 *opt_end = (void *)((char *)pos + doff);
-	while (opt < opt_end) {
-		__u8 kind = *opt;
-		if (kind == 0) break;
-		if (kind == 1) { e.opts |= (1<<4); opt++; continue; }
-		if (opt + 1 >= opt_end) break;
-		__u8 len = *(opt + 1);
-		if (len < 2 || opt + len > opt_end) break;
-		if (kind == 2 && len == 4) { e.mss = bpf_ntohs(*(__u16 *)(opt + 2)); e.opts |= (1<<0); }
-		if (kind == 3) { e.opts |= (1<<1); }
-		if (kind == 4) { e.opts |= (1<<2); }
-		if (kind == 8 && len == 10) { e.opts |= (1<<3); }
-		opt += len;
-	}
-	if (tcph.ece) e.ecn = 1;
-	bpf_perf_event_output(ctx, &events, BPF_F_CURRENT_CPU, &e, sizeof(e));
+	__u64 len = (char *)end - (char *)data;
+	e.pkt_len = len;
+	if (len > MAX_CAPTURE) len = MAX_CAPTURE;
+	e.cap_len = len;
+	bpf_perf_event_output(ctx, &events, BPF_F_CURRENT_CPU | (len << 32), &e, sizeof(e));
 	return XDP_PASS;
 }
 
@@ -9,3 +9,5 @@ require (
 	github.com/google/gopacket v1.1.19
 	golang.org/x/sys v0.37.0
 )
+
+require golang.org/x/net v0.46.0 // indirect
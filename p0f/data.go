@@ -1,149 +1,713 @@
 package p0f
 
-var Data = DB{Entries: []Entry{
-{Section: "mtu", Label: "Ethernet or modem", Sys: "", Sig: []string{"576","1500"}},
-{Section: "mtu", Label: "DSL", Sys: "", Sig: []string{"1452","1454","1492"}},
-{Section: "mtu", Label: "GIF", Sys: "", Sig: []string{"1240","1280"}},
-{Section: "mtu", Label: "generic tunnel or VPN", Sys: "", Sig: []string{"1300","1400","1420","1440","1450","1460"}},
-{Section: "mtu", Label: "IPSec or GRE", Sys: "", Sig: []string{"1476"}},
-{Section: "mtu", Label: "IPIP or SIT", Sys: "", Sig: []string{"1480"}},
-{Section: "mtu", Label: "PPTP", Sys: "", Sig: []string{"1490"}},
-{Section: "mtu", Label: "AX.25 radio modem", Sys: "", Sig: []string{"256"}},
-{Section: "mtu", Label: "SLIP", Sys: "", Sig: []string{"552"}},
-{Section: "mtu", Label: "Google", Sys: "", Sig: []string{"1470"}},
-{Section: "mtu", Label: "VLAN", Sys: "", Sig: []string{"1496"}},
-{Section: "mtu", Label: "Ericsson HIS modem", Sys: "", Sig: []string{"1656"}},
-{Section: "mtu", Label: "jumbo Ethernet", Sys: "", Sig: []string{"9000"}},
-{Section: "mtu", Label: "loopback", Sys: "", Sig: []string{"3924","16384","16436"}},
-{Section: "tcp:request", Label: "s:unix:Linux:3.11 and newer", Sys: "", Sig: []string{"*:64:0:*:mss*20,10:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*20,7:mss,sok,ts,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:Linux:3.1-3.10", Sys: "", Sig: []string{"*:64:0:*:mss*10,4:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*10,5:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*10,6:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*10,7:mss,sok,ts,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:Linux:2.6.x", Sys: "", Sig: []string{"*:64:0:*:mss*4,6:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*4,7:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*4,8:mss,sok,ts,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:Linux:2.4.x", Sys: "", Sig: []string{"*:64:0:*:mss*4,0:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*4,1:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*4,2:mss,sok,ts,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:Linux:2.2.x", Sys: "", Sig: []string{"*:64:0:*:mss*11,0:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*20,0:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*22,0:mss,sok,ts,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:Linux:2.0", Sys: "", Sig: []string{"*:64:0:*:mss*12,0:mss::0","*:64:0:*:16384,0:mss::0"}},
-{Section: "tcp:request", Label: "s:unix:Linux:3.x (loopback)", Sys: "", Sig: []string{"*:64:0:16396:mss*2,4:mss,sok,ts,nop,ws:df,id+:0","*:64:0:16376:mss*2,4:mss,sok,ts,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:Linux:2.6.x (loopback)", Sys: "", Sig: []string{"*:64:0:16396:mss*2,2:mss,sok,ts,nop,ws:df,id+:0","*:64:0:16376:mss*2,2:mss,sok,ts,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:Linux:2.4.x (loopback)", Sys: "", Sig: []string{"*:64:0:16396:mss*2,0:mss,sok,ts,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:Linux:2.2.x (loopback)", Sys: "", Sig: []string{"*:64:0:3884:mss*8,0:mss,sok,ts,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:Linux:2.6.x (Google crawler)", Sys: "", Sig: []string{"4:64:0:1430:mss*4,6:mss,sok,ts,nop,ws::0"}},
-{Section: "tcp:request", Label: "s:unix:Linux:(Android)", Sys: "", Sig: []string{"*:64:0:*:mss*44,1:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*44,3:mss,sok,ts,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "g:unix:Linux:3.x", Sys: "", Sig: []string{"*:64:0:*:mss*10,*:mss,sok,ts,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "g:unix:Linux:2.4.x-2.6.x", Sys: "", Sig: []string{"*:64:0:*:mss*4,*:mss,sok,ts,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "g:unix:Linux:2.2.x-3.x", Sys: "", Sig: []string{"*:64:0:*:*,*:mss,sok,ts,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "g:unix:Linux:2.2.x-3.x (no timestamps)", Sys: "", Sig: []string{"*:64:0:*:*,*:mss,nop,nop,sok,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "g:unix:Linux:2.2.x-3.x (barebone)", Sys: "", Sig: []string{"*:64:0:*:*,0:mss:df,id+:0"}},
-{Section: "tcp:request", Label: "s:win:Windows:XP", Sys: "", Sig: []string{"*:128:0:*:16384,0:mss,nop,nop,sok:df,id+:0","*:128:0:*:65535,0:mss,nop,nop,sok:df,id+:0","*:128:0:*:65535,0:mss,nop,ws,nop,nop,sok:df,id+:0","*:128:0:*:65535,1:mss,nop,ws,nop,nop,sok:df,id+:0","*:128:0:*:65535,2:mss,nop,ws,nop,nop,sok:df,id+:0"}},
-{Section: "tcp:request", Label: "s:win:Windows:7 or 8", Sys: "", Sig: []string{"*:128:0:*:8192,0:mss,nop,nop,sok:df,id+:0","*:128:0:*:8192,2:mss,nop,ws,nop,nop,sok:df,id+:0","*:128:0:*:8192,8:mss,nop,ws,nop,nop,sok:df,id+:0","*:128:0:*:8192,2:mss,nop,ws,sok,ts:df,id+:0"}},
-{Section: "tcp:request", Label: "s:win:Windows:7 (Websense crawler)", Sys: "", Sig: []string{"*:64:0:1380:mss*4,6:mss,nop,nop,ts,nop,ws:df,id+:0","*:64:0:1380:mss*4,7:mss,nop,nop,ts,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "g:win:Windows:NT kernel 5.x", Sys: "", Sig: []string{"*:128:0:*:16384,*:mss,nop,nop,sok:df,id+:0","*:128:0:*:65535,*:mss,nop,nop,sok:df,id+:0","*:128:0:*:16384,*:mss,nop,ws,nop,nop,sok:df,id+:0","*:128:0:*:65535,*:mss,nop,ws,nop,nop,sok:df,id+:0"}},
-{Section: "tcp:request", Label: "g:win:Windows:NT kernel 6.x", Sys: "", Sig: []string{"*:128:0:*:8192,*:mss,nop,nop,sok:df,id+:0","*:128:0:*:8192,*:mss,nop,ws,nop,nop,sok:df,id+:0"}},
-{Section: "tcp:request", Label: "g:win:Windows:NT kernel", Sys: "", Sig: []string{"*:128:0:*:*,*:mss,nop,nop,sok:df,id+:0","*:128:0:*:*,*:mss,nop,ws,nop,nop,sok:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:Mac OS X:10.x", Sys: "", Sig: []string{"*:64:0:*:65535,1:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0","*:64:0:*:65535,3:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:MacOS X:10.9 or newer (sometimes iPhone or iPad)", Sys: "", Sig: []string{"*:64:0:*:65535,4:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:iOS:iPhone or iPad", Sys: "", Sig: []string{"*:64:0:*:65535,2:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0"}},
-{Section: "tcp:request", Label: "g:unix:Mac OS X:", Sys: "", Sig: []string{"*:64:0:*:65535,*:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:FreeBSD:9.x or newer", Sys: "", Sig: []string{"*:64:0:*:65535,6:mss,nop,ws,sok,ts:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:FreeBSD:8.x", Sys: "", Sig: []string{"*:64:0:*:65535,3:mss,nop,ws,sok,ts:df,id+:0"}},
-{Section: "tcp:request", Label: "g:unix:FreeBSD:", Sys: "", Sig: []string{"*:64:0:*:65535,*:mss,nop,ws,sok,ts:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:OpenBSD:3.x", Sys: "", Sig: []string{"*:64:0:*:16384,0:mss,nop,nop,sok,nop,ws,nop,nop,ts:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:OpenBSD:4.x-5.x", Sys: "", Sig: []string{"*:64:0:*:16384,3:mss,nop,nop,sok,nop,ws,nop,nop,ts:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:Solaris:8", Sys: "", Sig: []string{"*:64:0:*:32850,1:nop,ws,nop,nop,ts,nop,nop,sok,mss:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:Solaris:10", Sys: "", Sig: []string{"*:64:0:*:mss*34,0:mss,nop,ws,nop,nop,sok:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:OpenVMS:8.x", Sys: "", Sig: []string{"4:128:0:1460:mtu*2,0:mss,nop,ws::0"}},
-{Section: "tcp:request", Label: "s:unix:OpenVMS:7.x", Sys: "", Sig: []string{"4:64:0:1460:61440,0:mss,nop,ws::0"}},
-{Section: "tcp:request", Label: "s:other:NeXTSTEP:", Sys: "", Sig: []string{"4:64:0:1024:mss*4,0:mss::0"}},
-{Section: "tcp:request", Label: "s:unix:Tru64:4.x", Sys: "", Sig: []string{"4:64:0:1460:32768,0:mss,nop,ws:df,id+:0"}},
-{Section: "tcp:request", Label: "s:!:NMap:SYN scan", Sys: "@unix,@win", Sig: []string{"*:64-:0:1460:1024,0:mss::0","*:64-:0:1460:2048,0:mss::0","*:64-:0:1460:3072,0:mss::0","*:64-:0:1460:4096,0:mss::0"}},
-{Section: "tcp:request", Label: "s:!:NMap:OS detection", Sys: "@unix,@win", Sig: []string{"*:64-:0:265:512,0:mss,sok,ts:ack+:0","*:64-:0:0:4,10:sok,ts,ws,eol+0:ack+:0","*:64-:0:1460:1,10:ws,nop,mss,ts,sok:ack+:0","*:64-:0:536:16,10:mss,sok,ts,ws,eol+0:ack+:0","*:64-:0:640:4,5:ts,nop,nop,ws,nop,mss:ack+:0","*:64-:0:1400:63,0:mss,ws,sok,ts,eol+0:ack+:0","*:64-:0:265:31337,10:ws,nop,mss,ts,sok:ack+:0","*:64-:0:1460:3,10:ws,nop,mss,sok,nop,nop:ecn,uptr+:0"}},
-{Section: "tcp:request", Label: "s:unix:p0f:sendsyn utility", Sys: "", Sig: []string{"*:192:0:1331:1337,0:mss,nop,eol+18::0","*:192:0:1331:1337,0:mss,ts,nop,eol+8::0","*:192:0:1331:1337,5:mss,ws,nop,eol+15::0","*:192:0:1331:1337,0:mss,sok,nop,eol+16::0","*:192:0:1331:1337,5:mss,ws,ts,nop,eol+5::0","*:192:0:1331:1337,0:mss,sok,ts,nop,eol+6::0","*:192:0:1331:1337,5:mss,ws,sok,nop,eol+13::0","*:192:0:1331:1337,5:mss,ws,sok,ts,nop,eol+3::0"}},
-{Section: "tcp:request", Label: "s:other:Blackberry:", Sys: "", Sig: []string{"*:128:0:1452:65535,0:mss,nop,nop,sok,nop,nop,ts::0"}},
-{Section: "tcp:request", Label: "s:other:Nintendo:3DS", Sys: "", Sig: []string{"*:64:0:1360:32768,0:mss,nop,nop,sok:df,id+:0"}},
-{Section: "tcp:request", Label: "s:other:Nintendo:Wii", Sys: "", Sig: []string{"4:64:0:1460:32768,0:mss,nop,nop,sok:df,id+:0"}},
-{Section: "tcp:request", Label: "s:unix:BaiduSpider:", Sys: "", Sig: []string{"*:64:0:1460:mss*4,7:mss,sok,nop,nop,nop,nop,nop,nop,nop,nop,nop,nop,nop,ws:df,id+:0","*:64:0:1460:mss*4,2:mss,sok,nop,nop,nop,nop,nop,nop,nop,nop,nop,nop,nop,ws:df,id+:0"}},
-{Section: "tcp:response", Label: "s:unix:Linux:3.x", Sys: "", Sig: []string{"*:64:0:*:mss*10,0:mss:df:0","*:64:0:*:mss*10,0:mss,sok,ts:df:0","*:64:0:*:mss*10,0:mss,nop,nop,ts:df:0","*:64:0:*:mss*10,0:mss,nop,nop,sok:df:0","*:64:0:*:mss*10,*:mss,nop,ws:df:0","*:64:0:*:mss*10,*:mss,sok,ts,nop,ws:df:0","*:64:0:*:mss*10,*:mss,nop,nop,ts,nop,ws:df:0","*:64:0:*:mss*10,*:mss,nop,nop,sok,nop,ws:df:0"}},
-{Section: "tcp:response", Label: "s:unix:Linux:2.4-2.6", Sys: "", Sig: []string{"*:64:0:*:mss*4,0:mss:df:0","*:64:0:*:mss*4,0:mss,sok,ts:df:0","*:64:0:*:mss*4,0:mss,nop,nop,ts:df:0","*:64:0:*:mss*4,0:mss,nop,nop,sok:df:0"}},
-{Section: "tcp:response", Label: "s:unix:Linux:2.4.x", Sys: "", Sig: []string{"*:64:0:*:mss*4,0:mss,nop,ws:df:0","*:64:0:*:mss*4,0:mss,sok,ts,nop,ws:df:0","*:64:0:*:mss*4,0:mss,nop,nop,ts,nop,ws:df:0","*:64:0:*:mss*4,0:mss,nop,nop,sok,nop,ws:df:0"}},
-{Section: "tcp:response", Label: "s:unix:Linux:2.6.x", Sys: "", Sig: []string{"*:64:0:*:mss*4,*:mss,nop,ws:df:0","*:64:0:*:mss*4,*:mss,sok,ts,nop,ws:df:0","*:64:0:*:mss*4,*:mss,nop,nop,ts,nop,ws:df:0","*:64:0:*:mss*4,*:mss,nop,nop,sok,nop,ws:df:0"}},
-{Section: "tcp:response", Label: "s:win:Windows:XP", Sys: "", Sig: []string{"*:128:0:*:65535,0:mss:df,id+:0","*:128:0:*:65535,0:mss,nop,ws:df,id+:0","*:128:0:*:65535,0:mss,nop,nop,sok:df,id+:0","*:128:0:*:65535,0:mss,nop,nop,ts:df,id+,ts1-:0","*:128:0:*:65535,0:mss,nop,ws,nop,nop,sok:df,id+:0","*:128:0:*:65535,0:mss,nop,ws,nop,nop,ts:df,id+,ts1-:0","*:128:0:*:65535,0:mss,nop,nop,ts,nop,nop,sok:df,id+,ts1-:0","*:128:0:*:65535,0:mss,nop,ws,nop,nop,ts,nop,nop,sok:df,id+,ts1-:0","*:128:0:*:16384,0:mss:df,id+:0","*:128:0:*:16384,0:mss,nop,ws:df,id+:0","*:128:0:*:16384,0:mss,nop,nop,sok:df,id+:0","*:128:0:*:16384,0:mss,nop,nop,ts:df,id+,ts1-:0","*:128:0:*:16384,0:mss,nop,ws,nop,nop,sok:df,id+:0","*:128:0:*:16384,0:mss,nop,ws,nop,nop,ts:df,id+,ts1-:0","*:128:0:*:16384,0:mss,nop,nop,ts,nop,nop,sok:df,id+,ts1-:0","*:128:0:*:16384,0:mss,nop,ws,nop,nop,ts,nop,nop,sok:df,id+,ts1-:0"}},
-{Section: "tcp:response", Label: "s:win:Windows:7 or 8", Sys: "", Sig: []string{"*:128:0:*:8192,0:mss:df,id+:0","*:128:0:*:8192,0:mss,sok,ts:df,id+:0","*:128:0:*:8192,8:mss,nop,ws:df,id+:0","*:128:0:*:8192,0:mss,nop,nop,ts:df,id+:0","*:128:0:*:8192,0:mss,nop,nop,sok:df,id+:0","*:128:0:*:8192,8:mss,nop,ws,sok,ts:df,id+:0","*:128:0:*:8192,8:mss,nop,ws,nop,nop,ts:df,id+:0","*:128:0:*:8192,8:mss,nop,ws,nop,nop,sok:df,id+:0"}},
-{Section: "tcp:response", Label: "s:unix:FreeBSD:9.x", Sys: "", Sig: []string{"*:64:0:*:65535,6:mss,nop,ws:df,id+:0","*:64:0:*:65535,6:mss,nop,ws,sok,ts:df,id+:0","*:64:0:*:65535,6:mss,nop,ws,sok,eol+1:df,id+:0","*:64:0:*:65535,6:mss,nop,ws,nop,nop,ts:df,id+:0"}},
-{Section: "tcp:response", Label: "s:unix:FreeBSD:8.x", Sys: "", Sig: []string{"*:64:0:*:65535,3:mss,nop,ws:df,id+:0","*:64:0:*:65535,3:mss,nop,ws,sok,ts:df,id+:0","*:64:0:*:65535,3:mss,nop,ws,sok,eol+1:df,id+:0","*:64:0:*:65535,3:mss,nop,ws,nop,nop,ts:df,id+:0"}},
-{Section: "tcp:response", Label: "s:unix:FreeBSD:8.x-9.x", Sys: "", Sig: []string{"*:64:0:*:65535,0:mss,sok,ts:df,id+:0","*:64:0:*:65535,0:mss,sok,eol+1:df,id+:0","*:64:0:*:65535,0:mss,nop,nop,ts:df,id+:0"}},
-{Section: "tcp:response", Label: "s:unix:OpenBSD:5.x", Sys: "", Sig: []string{"*:64:0:1460:16384,0:mss,nop,nop,sok:df,id+:0","*:64:0:1460:16384,3:mss,nop,ws:df,id+:0","*:64:0:1460:16384,3:mss,nop,nop,sok,nop,ws:df,id+:0","*:64:0:1460:16384,0:mss,nop,nop,ts:df,id+:0","*:64:0:1460:16384,0:mss,nop,nop,sok,nop,nop,ts:df,id+:0","*:64:0:1460:16384,3:mss,nop,ws,nop,nop,ts:df,id+:0","*:64:0:1460:16384,3:mss,nop,nop,sok,nop,ws,nop,nop,ts:df,id+:0"}},
-{Section: "tcp:response", Label: "s:unix:Mac OS X:10.x", Sys: "", Sig: []string{"*:64:0:*:65535,0:mss,nop,ws:df,id+:0","*:64:0:*:65535,0:mss,sok,eol+1:df,id+:0","*:64:0:*:65535,0:mss,nop,nop,ts:df,id+:0","*:64:0:*:65535,0:mss,nop,ws,sok,eol+1:df,id+:0","*:64:0:*:65535,0:mss,nop,ws,nop,nop,ts:df,id+:0","*:64:0:*:65535,0:mss,nop,nop,ts,sok,eol+1:df,id+:0","*:64:0:*:65535,0:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0"}},
-{Section: "tcp:response", Label: "s:unix:Solaris:6", Sys: "", Sig: []string{"4:255:0:*:mss*7,0:mss:df,id+:0","4:255:0:*:mss*7,0:nop,ws,mss:df,id+:0","4:255:0:*:mss*7,0:nop,nop,ts,mss:df,id+:0","4:255:0:*:mss*7,0:nop,nop,ts,nop,ws,mss:df,id+:0"}},
-{Section: "tcp:response", Label: "s:unix:Solaris:8", Sys: "", Sig: []string{"*:64:0:*:mss*19,0:mss:df,id+:0","*:64:0:*:mss*19,0:nop,ws,mss:df,id+:0","*:64:0:*:mss*19,0:nop,nop,ts,mss:df,id+:0","*:64:0:*:mss*19,0:nop,nop,sok,mss:df,id+:0","*:64:0:*:mss*19,0:nop,nop,ts,nop,ws,mss:df,id+:0","*:64:0:*:mss*19,0:nop,ws,nop,nop,sok,mss:df,id+:0","*:64:0:*:mss*19,0:nop,nop,ts,nop,nop,sok,mss:df,id+:0","*:64:0:*:mss*19,0:nop,nop,ts,nop,ws,nop,nop,sok,mss:df,id+:0"}},
-{Section: "tcp:response", Label: "s:unix:Solaris:10", Sys: "", Sig: []string{"*:64:0:*:mss*37,0:mss:df,id+:0","*:64:0:*:mss*37,0:mss,nop,ws:df,id+:0","*:64:0:*:mss*37,0:nop,nop,ts,mss:df,id+:0","*:64:0:*:mss*37,0:mss,nop,nop,sok:df,id+:0","*:64:0:*:mss*37,0:nop,nop,ts,mss,nop,ws:df,id+:0","*:64:0:*:mss*37,0:mss,nop,ws,nop,nop,sok:df,id+:0","*:64:0:*:mss*37,0:nop,nop,ts,mss,nop,nop,sok:df,id+:0","*:64:0:*:mss*37,0:nop,nop,ts,mss,nop,ws,nop,nop,sok:df,id+:0"}},
-{Section: "tcp:response", Label: "s:unix:HP-UX:11.x", Sys: "", Sig: []string{"*:64:0:*:32768,0:mss:df,id+:0","*:64:0:*:32768,0:mss,ws,nop:df,id+:0","*:64:0:*:32768,0:mss,nop,nop,ts:df,id+:0","*:64:0:*:32768,0:mss,nop,nop,sok:df,id+:0","*:64:0:*:32768,0:mss,ws,nop,nop,nop,ts:df,id+:0","*:64:0:*:32768,0:mss,nop,nop,sok,ws,nop:df,id+:0","*:64:0:*:32768,0:mss,nop,nop,sok,nop,nop,ts:df,id+:0","*:64:0:*:32768,0:mss,nop,nop,sok,ws,nop,nop,nop,ts:df,id+:0"}},
-{Section: "tcp:response", Label: "s:other:OpenVMS:7.x", Sys: "", Sig: []string{"4:64:0:1460:3993,0:mss::0","4:64:0:1460:3993,0:mss,nop,ws::0"}},
-{Section: "tcp:response", Label: "s:unix:Tru64:4.x", Sys: "", Sig: []string{"4:64:0:1460:mss*25,0:mss,nop,ws:df,id+:0","4:64:0:1460:mss*25,0:mss:df,id+:0"}},
-{Section: "http:request", Label: "s:!:Firefox:2.x", Sys: "Windows,@unix", Sig: []string{"*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip,deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],Keep-Alive=[300],Connection=[keep-alive]::Firefox/"}},
-{Section: "http:request", Label: "s:!:Firefox:3.x", Sys: "Windows,@unix", Sig: []string{"*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip,deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],Keep-Alive=[115],Connection=[keep-alive],?Referer::Firefox/"}},
-{Section: "http:request", Label: "s:!:Firefox:4.x", Sys: "Windows,@unix", Sig: []string{"*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],Keep-Alive=[115],Connection=[keep-alive],?Referer::Firefox/"}},
-{Section: "http:request", Label: "s:!:Firefox:5.x-9.x", Sys: "Windows,@unix", Sig: []string{"*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],?DNT=[1],Connection=[keep-alive],?Referer:Keep-Alive:Firefox/","*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[UTF-8,*],?DNT=[1],Connection=[keep-alive],?Referer:Keep-Alive:Firefox/","*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[UTF-8,*],?DNT=[1],?Referer,Connection=[keep-alive]:Keep-Alive:Firefox/","*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],?DNT=[1],?Referer,Connection=[keep-alive]:Keep-Alive:Firefox/","*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],?Referer,?DNT=[1],Connection=[keep-alive]:Keep-Alive:Firefox/"}},
-{Section: "http:request", Label: "s:!:Firefox:10.x or newer", Sys: "Windows,@unix", Sig: []string{"*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language=[;q=],Accept-Encoding=[gzip, deflate],?DNT=[1],Connection=[keep-alive],?Referer:Accept-Charset,Keep-Alive:Firefox/","*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language=[;q=],Accept-Encoding=[gzip, deflate],?DNT=[1],?Referer,Connection=[keep-alive]:Accept-Charset,Keep-Alive:Firefox/"}},
-{Section: "http:request", Label: "s:!:Firefox:10.x or Safari 5.x", Sys: "Windows,@unix", Sig: []string{"*:Host,User-Agent,Accept=[xml;q=0.9,*/*;q=0.8],Accept-Language,Accept-Encoding=[gzip, deflate],Connection=[keep-alive]:Keep-Alive,Accept-Charset,DNT,Referer:Gecko"}},
-{Section: "http:request", Label: "s:!:MSIE:8 or newer", Sys: "Windows", Sig: []string{"1:Accept=[*/*],?Referer,?Accept-Language,User-Agent,Accept-Encoding=[gzip, deflate],Host,Connection=[Keep-Alive]:Keep-Alive,Accept-Charset,UA-CPU:Trident/","1:Accept=[*/*],?Referer,?Accept-Language,Accept-Encoding=[gzip, deflate],User-Agent,Host,Connection=[Keep-Alive]:Keep-Alive,Accept-Charset:(compatible; MSIE"}},
-{Section: "http:request", Label: "s:!:MSIE:7", Sys: "Windows", Sig: []string{"1:Accept=[*/*],?Referer,?Accept-Language,UA-CPU,User-Agent,Accept-Encoding=[gzip, deflate],Host,Connection=[Keep-Alive]:Keep-Alive,Accept-Charset:(compatible; MSIE"}},
-{Section: "http:request", Label: "s:!:MSIE:6", Sys: "Windows", Sig: []string{"0:Accept=[*/*],?Referer,User-Agent,Host:Keep-Alive,Connection,Accept-Encoding,Accept-Language,Accept-Charset:(compatible; MSIE","1:Accept=[*/*],Connection=[Keep-Alive],Host,?Pragma=[no-cache],?Range,?Referer,User-Agent:Keep-Alive,Accept-Encoding,Accept-Language,Accept-Charset:(compatible; MSIE"}},
-{Section: "http:request", Label: "s:!:Chrome:11.x to 26.x", Sys: "Windows,@unix", Sig: []string{"1:Host,Connection=[keep-alive],User-Agent,Accept=[*/*],?Referer,Accept-Encoding=[gzip,deflate,sdch],Accept-Language,Accept-Charset=[utf-8;q=0.7,*;q=0.3]:: Chrom","1:Host,Connection=[keep-alive],User-Agent,Accept=[*/*],?Referer,Accept-Encoding=[gzip,deflate,sdch],Accept-Language,Accept-Charset=[UTF-8,*;q=0.5]:: Chrom","1:Host,User-Agent,Accept=[*/*],?Referer,Accept-Encoding=[gzip,deflate,sdch],Accept-Language,Accept-Charset=[utf-8;q=0.7,*;q=0.3],Connection=[keep-alive]::Chrom"}},
-{Section: "http:request", Label: "s:!:Chrome:27.x to 42.x", Sys: "Windows,@unix", Sig: []string{"1:Host,Connection=[keep-alive],Accept=[*/*],User-Agent,?Referer,Accept-Encoding=[gzip,deflate,sdch],Accept-Language:Accept-Charset,Keep-Alive: Chrom"}},
-{Section: "http:request", Label: "s:!:Chrome:43.x or 50.x", Sys: "Windows,@unix", Sig: []string{"1:Host,Connection=[keep-alive],Accept=[*/*],User-Agent,?Referer,Accept-Encoding=[gzip, deflate, sdch],Accept-Language:Accept-Charset,Keep-Alive: Chrom"}},
-{Section: "http:request", Label: "s:!:Chrome:51.x or newer", Sys: "Windows,@unix", Sig: []string{"1:Host,Connection=[keep-alive],Upgrade-Insecure-Requests=[1],User-Agent,Accept=[*/*],Accept-Encoding=[gzip, deflate, sdch],Accept-Language:Accept-Charset,Keep-Alive: Chrom"}},
-{Section: "http:request", Label: "s:!:Opera:19.x or newer", Sys: "Windows,@unix", Sig: []string{"1:Host,Connection=[keep-alive],Accept=[*/*;q=0.8],User-Agent,Accept-Encoding=[gzip,deflate,lzma,sdch],Accept-Language=[;q=0.]:Accept-Charset,Keep-Alive:OPR/"}},
-{Section: "http:request", Label: "s:!:Opera:15.x-18.x", Sys: "Windows,@unix", Sig: []string{"1:Host,Connection=[keep-alive],Accept=[*/*;q=0.8],User-Agent,Accept-Encoding=[gzip, deflate],Accept-Language=[;q=0.]:Accept-Charset,Keep-Alive:OPR/"}},
-{Section: "http:request", Label: "s:!:Opera:11.x-14.x", Sys: "Windows,@unix", Sig: []string{"1:User-Agent,Host,Accept=[*/*;q=0.1],?Accept-Language=[;q=0.],Accept-Encoding=[gzip, deflate],Connection=[Keep-Alive]:Accept-Charset,X-OperaMini-Phone-UA:) Presto/"}},
-{Section: "http:request", Label: "s:!:Opera:10.x", Sys: "Windows,@unix", Sig: []string{"1:User-Agent,Host,Accept=[*/*;q=0.1],Accept-Language=[;q=0.],Accept-Charset=[utf-8, utf-16, *;q=0.1],Accept-Encoding=[deflate, gzip, x-gzip, identity, *;q=0],Connection=[Keep-Alive]::Presto/","1:User-Agent,Host,Accept=[*/*;q=0.1],Accept-Language=[en],Accept-Encoding=[gzip, deflate],Connection=[Keep-Alive]:Accept-Charset:Opera/"}},
-{Section: "http:request", Label: "s:!:Opera:Mini", Sys: "Linux", Sig: []string{"1:User-Agent,Host,Accept=[*/*;q=0.1],Accept-Language=[;q=0.],Accept-Encoding=[gzip, deflate],Connection=[Keep-Alive],X-OperaMini-Phone-UA,X-OperaMini-Features,X-OperaMini-Phone,x-forwarded-for:Accept-Charset:Opera Mini/"}},
-{Section: "http:request", Label: "s:!:Opera:on Nintendo Wii", Sys: "Nintendo", Sig: []string{"1:User-Agent,Host,Accept=[*/*;q=0.1],Accept-Language=[en],Accept-Charset=[iso-8859-1, utf-8, utf-16, *;q=0.1],Accept-Encoding=[deflate, gzip, x-gzip, identity, *;q=0],Connection=[Keep-Alive]::Nintendo"}},
-{Section: "http:request", Label: "s:!:Android:2.x", Sys: "Linux", Sig: []string{"1:Host,Accept-Encoding=[gzip],Accept-Language,User-Agent,Accept=[,*/*;q=0.5],Accept-Charset=[utf-16, *;q=0.7]:Connection:Android","1:Host,Connection=[keep-alive],Accept-Encoding=[gzip],Accept-Language,User-Agent,Accept=[,*/*;q=0.5],Accept-Charset=[utf-16, *;q=0.7]::Android","1:Host,Accept-Encoding=[gzip],Accept-Language=[en-US],Accept=[*/*;q=0.5],User-Agent,Accept-Charset=[utf-16, *;q=0.7]:Connection:Android"}},
-{Section: "http:request", Label: "s:!:Android:4.x", Sys: "Linux", Sig: []string{"1:Host,Connection=[keep-alive],Accept=[,*/*;q=0.8],User-Agent,Accept-Encoding=[gzip,deflate],Accept-Language,Accept-Charset=[utf-16, *;q=0.7]::Android"}},
-{Section: "http:request", Label: "s:!:Safari:7 or newer", Sys: "@unix", Sig: []string{"*:Host,Accept-Encoding=[gzip, deflate],Connection=[keep-alive],Accept=[*/*],User-Agent,Accept-Language,?Referer,?DNT:Accept-Charset,Keep-Alive:KHTML, like Gecko)"}},
-{Section: "http:request", Label: "s:!:Safari:5.1-6", Sys: "Windows,@unix", Sig: []string{"*:Host,User-Agent,Accept=[*/*],?Referer,Accept-Language,Accept-Encoding=[gzip, deflate],Connection=[keep-alive]:Accept-Charset:KHTML, like Gecko)","*:Host,User-Agent,Accept=[*/*],?Referer,Accept-Encoding=[gzip, deflate],Accept-Language,Connection=[keep-alive]:Accept-Charset:KHTML, like Gecko)"}},
-{Section: "http:request", Label: "s:!:Safari:5.0 or earlier", Sys: "Mac OS X", Sig: []string{"0:Host,User-Agent,Connection=[close]:Accept,Accept-Encoding,Accept-Language,Accept-Charset:CFNetwork/"}},
-{Section: "http:request", Label: "s:!:Konqueror:4.6 or earlier", Sys: "Linux,FreeBSD,OpenBSD", Sig: []string{"1:Host,Connection=[Keep-Alive],User-Agent,?Pragma,?Cache-control,Accept=[*/*],Accept-Encoding=[x-gzip, x-deflate, gzip, deflate],Accept-Charset=[;q=0.5, *;q=0.5],Accept-Language::Konqueror/"}},
-{Section: "http:request", Label: "s:!:Konqueror:4.7 or newer", Sys: "Linux,FreeBSD,OpenBSD", Sig: []string{"1:Host,Connection=[keep-alive],User-Agent,Accept=[*/*],Accept-Encoding=[gzip, deflate, x-gzip, x-deflate],Accept-Charset=[,*;q=0.5],Accept-Language::Konqueror/"}},
-{Section: "http:request", Label: "s:!:BaiduSpider:", Sys: "BaiduSpider", Sig: []string{"1:Host,Connection=[close],User-Agent,Accept=[*/*]:Accept-Encoding,Accept-Language,Accept-Charset:Baiduspider-image","1:Host,Accept-Language=[zh-cn],Connection=[close],User-Agent:Accept,Accept-Encoding,Accept-Charset:Baiduspider","1:Host,Connection=[close],User-Agent,Accept-Language=[zh-cn,zh-tw],Accept-Encoding=[gzip],Accept=[*/*]:Accept-Charset:Baiduspider","1:Host,Connection=[close],User-Agent,Accept-Language=[tr-TR],Accept-Encoding=[gzip],Accept=[*/*]:Accept-Charset:Baiduspider","1:Host,Connection=[close],User-Agent,Accept-Encoding=[gzip],?Accept-Language=[zh-cn,zh-tw],Accept=[*/*]:Accept-Charset:Baiduspider","1:Host,Connection=[close],User-Agent,Accept-Encoding=[gzip],Accept-Language=[tr-TR],Accept=[*/*]:Accept-Charset:Baiduspider"}},
-{Section: "http:request", Label: "s:!:Googlebot:", Sys: "Linux", Sig: []string{"1:Host,Connection=[Keep-alive],Accept=[*/*],From=[googlebot(at)googlebot.com],User-Agent,Accept-Encoding=[gzip,deflate],?If-Modified-Since:Accept-Language,Accept-Charset:Googlebot","1:Host,Connection=[Keep-alive],Accept=[text/plain],Accept=[text/html],From=[googlebot(at)googlebot.com],User-Agent,Accept-Encoding=[gzip,deflate]:Accept-Language,Accept-Charset:Googlebot"}},
-{Section: "http:request", Label: "s:!:Googlebot:feed fetcher", Sys: "Linux", Sig: []string{"1:Host,Connection=[Keep-alive],Accept=[*/*],User-Agent,Accept-Encoding=[gzip,deflate],?If-Modified-Since:Accept-Language,Accept-Charset:-Google","1:User-Agent,?X-shindig-dos=[on],Cache-Control,Host,?X-Forwarded-For,Accept-Encoding=[gzip],?Accept-Language:Connection,Accept,Accept-Charset:Feedfetcher-Google"}},
-{Section: "http:request", Label: "s:!:Bingbot:", Sys: "Windows", Sig: []string{"1:Cache-Control,Connection=[Keep-Alive],Pragma=[no-cache],Accept=[*/*],Accept-Encoding,Host,User-Agent:Accept-Language,Accept-Charset:bingbot/"}},
-{Section: "http:request", Label: "s:!:MSNbot:", Sys: "Windows", Sig: []string{"1:Connection=[Close],Accept,Accept-Encoding=[gzip, deflate],From=[msnbot(at)microsoft.com],Host,User-Agent:Accept-Language,Accept-Charset:msnbot"}},
-{Section: "http:request", Label: "s:!:Yandex:crawler", Sys: "FreeBSD", Sig: []string{"1:Host,Connection=[Keep-Alive],Accept=[*/*],Accept-Encoding=[gzip,deflate],Accept-Language=[en-us, en;q=0.7, *;q=0.01],User-Agent,From=[support@search.yandex.ru]:Accept-Charset:YandexBot/","1:Host,Connection=[Keep-Alive],Accept=[image/jpeg, image/pjpeg, image/png, image/gif],User-Agent,From=[support@search.yandex.ru]:Accept-Encoding,Accept-Language,Accept-Charset:YandexImages/","1:Host,Connection=[Keep-Alive],User-Agent,From=[support@search.yandex.ru]:Accept,Accept-Encoding,Accept-Language,Accept-Charset:YandexBot/"}},
-{Section: "http:request", Label: "s:!:Yahoo:crawler", Sys: "Linux", Sig: []string{"0:Host,User-Agent,Accept=[,image/png,*/*;q=0.5],Accept-Language=[en-us,en;q=0.5],Accept-Encoding=[gzip],Accept-Charset=[,utf-8;q=0.7,*;q=0.7]:Connection:Slurp"}},
-{Section: "http:request", Label: "s:!:Flipboard:crawler", Sys: "Linux", Sig: []string{"1:User-Agent,Accept-Language=[en-us,en;q=0.5],Accept-Charset=[;q=0.7,*;q=0.5],Accept-Encoding=[gzip],Host,Accept=[*; q=.2, */*; q=.2],Connection=[keep-alive]::FlipboardProxy","1:Accept-language=[en-us,en;q=0.5],Accept-encoding=[gzip],Accept=[;q=0.9,*/*;q=0.8],User-agent,Host:User-Agent,Connection,Accept-Encoding,Accept-Language,Accept-Charset:FlipboardProxy"}},
-{Section: "http:request", Label: "s:!:Spinn3r:crawler", Sys: "Linux", Sig: []string{"1:User-Agent,Accept-Encoding=[gzip],Host,Accept=[*; q=.2, */*; q=.2],Connection=[close]:Accept-Language,Accept-Charset:Spinn3r"}},
-{Section: "http:request", Label: "s:!:Facebook:crawler", Sys: "Linux", Sig: []string{"1:User-Agent,Host,Accept=[*/*],Accept-Encoding=[deflate, gzip],Connection=[close]:Accept-Language,Accept-Charset:facebookexternalhit/","1:User-Agent,Host,Accept=[*/*],Connection=[close]:Accept-Encoding,Accept-Language,Accept-Charset:facebookexternalhit/"}},
-{Section: "http:request", Label: "s:!:paper.li:crawler", Sys: "Linux", Sig: []string{"1:Accept-Language=[en-us,en;q=0.5],Accept=[*/*],User-Agent,Connection=[close],Accept-Encoding=[gzip,identity],?Referer,Host,Accept-Charset=[ISO-8859-1,utf-8;q=0.7,*;q=0.7]::PaperLiBot/"}},
-{Section: "http:request", Label: "s:!:Twitter:crawler", Sys: "Linux", Sig: []string{"1:User-Agent=[Twitterbot/],Host,Accept=[*; q=.2, */*; q=.2],Cache-Control,Connection=[keep-alive]:Accept-Encoding,Accept-Language,Accept-Charset:Twitterbot/"}},
-{Section: "http:request", Label: "s:!:linkdex:crawler", Sys: "Linux", Sig: []string{"0:Host,Connection=[Keep-Alive],User-Agent,Accept-Encoding=[gzip,deflate]:Accept,Accept-Language,Accept-Charset:linkdex.com/"}},
-{Section: "http:request", Label: "s:!:Yodaobot:", Sys: "Linux", Sig: []string{"1:Accept-Encoding=[identity;q=0.5, *;q=0.1],User-Agent,Host:Connection,Accept,Accept-Language,Accept-Charset:YodaoBot/"}},
-{Section: "http:request", Label: "s:!:Tweetmeme:crawler", Sys: "Linux", Sig: []string{"1:Host,User-Agent,Accept=[,image/png,*/*;q=0.5],Accept-Language=[en-gb,en;q=0.5],Accept-Charset=[ISO-8859-1,utf-8;q=0.7,*;q=0.7]:Connection,Accept-Encoding:TweetmemeBot/"}},
-{Section: "http:request", Label: "s:!:Archive.org:crawler", Sys: "Linux", Sig: []string{"0:User-Agent,Connection=[close],Accept=[application/xml;q=0.9,*/*;q=0.8],Host:Accept-Encoding,Accept-Language,Accept-Charset:archive.org"}},
-{Section: "http:request", Label: "s:!:Yahoo Pipes:", Sys: "Linux", Sig: []string{"0:Client-IP,X-Forwarded-For,X-YQL-Depth,User-Agent,Host,Connection=[keep-alive],Via:Accept,Accept-Encoding,Accept-Language,Accept-Charset:Yahoo Pipes","1:Client-IP,X-Forwarded-For,X-YQL-Depth,User-Agent,Host,Via:Connection,Accept,Accept-Encoding,Accept-Language,Accept-Charset:Yahoo Pipes"}},
-{Section: "http:request", Label: "s:!:Google Web Preview:", Sys: "Linux", Sig: []string{"1:Referer,User-Agent,Accept-Encoding=[gzip,deflate],Host,X-Forwarded-For:Connection,Accept,Accept-Language,Accept-Charset:Web Preview"}},
-{Section: "http:request", Label: "s:!:wget:", Sys: "@unix,Windows", Sig: []string{"*:User-Agent,Accept=[*/*],Host,Connection=[Keep-Alive]:Accept-Encoding,Accept-Language,Accept-Charset:Wget/"}},
-{Section: "http:request", Label: "s:!:Lynx:", Sys: "@unix,Windows", Sig: []string{"0:Host,Accept=[text/sgml, */*;q=0.01],Accept-Encoding=[gzip, compress],Accept-Language,User-Agent:Connection,Accept-Charset:Lynx/"}},
-{Section: "http:request", Label: "s:!:curl:", Sys: "@unix,Windows", Sig: []string{"1:User-Agent,Host,Accept=[*/*]:Connection,Accept-Encoding,Accept-Language,Accept-Charset:curl/"}},
-{Section: "http:request", Label: "s:!:links:", Sys: "@unix,Windows", Sig: []string{"1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[gzip, deflate, bzip2],Accept-Charset=[us-ascii],Accept-Language=[;q=0.1],Connection=[Keep-Alive]::Links","1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[gzip,deflate,bzip2],Accept-Charset=[us-ascii],Accept-Language=[;q=0.1],Connection=[keep-alive]::Links"}},
-{Section: "http:request", Label: "s:!:elinks:", Sys: "@unix,Windows", Sig: []string{"1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[bzip2, deflate, gzip],Accept-Language:Connection,Accept-Charset:ELinks/"}},
-{Section: "http:request", Label: "s:!:Java:JRE", Sys: "@unix,@win", Sig: []string{"1:User-Agent,Host,Accept=[*; q=.2, */*; q=.2],Connection=[keep-alive]:Accept-Encoding,Accept-Language,Accept-Charset:Java/"}},
-{Section: "http:request", Label: "s:!:Python:urllib", Sys: "@unix,Windows", Sig: []string{"1:Accept-Encoding=[identity],Host,Connection=[close],User-Agent:Accept,Accept-Language,Accept-Charset:Python-urllib/"}},
-{Section: "http:request", Label: "s:!:w3m:", Sys: "@unix,Windows", Sig: []string{"0:User-Agent,Accept=[image/*],Accept-Encoding=[gzip, compress, bzip, bzip2, deflate],Accept-Language=[;q=1.0],Host:Connection,Accept-Charset:w3m/"}},
-{Section: "http:request", Label: "s:!:libfetch:", Sys: "@unix", Sig: []string{"1:Host,User-Agent,Connection=[close]:Accept,Accept-Encoding,Accept-Language,Accept-Charset:libfetch/"}},
-{Section: "http:request", Label: "s:!:Google AppEngine:", Sys: "Linux", Sig: []string{"1:User-Agent,Host,Accept-Encoding=[gzip]:Connection,Accept,Accept-Language,Accept-Charset:AppEngine-Google"}},
-{Section: "http:request", Label: "s:!:WebOS:", Sys: "Linux", Sig: []string{"1:Host,Accept-Encoding=[gzip, deflate],User-Agent,Accept=[,*/*;q=0.5],Accept-Language,Accept-Charset=[utf-8;q=0.7,*;q=0.3]:Connection:wOSBrowser"}},
-{Section: "http:request", Label: "s:!:xxxterm:", Sys: "@unix", Sig: []string{"1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[gzip]:Connection,Accept-Language,Accept-Charset:xxxterm"}},
-{Section: "http:request", Label: "s:!:Google Desktop:", Sys: "Windows", Sig: []string{"1:Accept=[*/*],Accept-Encoding=[gzip],User-Agent,Host,Connection=[Keep-Alive]:Accept-Language,Accept-Charset:Google Desktop/"}},
-{Section: "http:request", Label: "s:!:luakit:", Sys: "@unix", Sig: []string{"1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[gzip],Connection=[Keep-Alive]:Accept-Language,Accept-Charset:luakit"}},
-{Section: "http:request", Label: "s:!:Epiphany:", Sys: "@unix", Sig: []string{"1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[gzip],Accept-Language:Connection,Accept-Charset,Keep-Alive:Epiphany/"}},
-{Section: "http:response", Label: "s:!:Apache:2.x", Sys: "@unix,Windows", Sig: []string{"1:Date,Server,?Last-Modified,?Accept-Ranges=[bytes],?Content-Length,?Content-Range,Keep-Alive=[timeout],Connection=[Keep-Alive],?Transfer-Encoding=[chunked],Content-Type::Apache","1:Date,Server,?Last-Modified,?Accept-Ranges=[bytes],?Content-Length,?Connection=[close],?Transfer-Encoding=[chunked],Content-Type:Keep-Alive:Apache","1:Date,Server,Connection=[Keep-Alive],Keep-Alive=[timeout]:Content-Type,Accept-Ranges:Apache","1:Date,Server,?Last-Modified,?Accept-Ranges=[bytes],?Content-Length,Content-Type,Keep-Alive=[timeout],Connection=[Keep-Alive]::Apache"}},
-{Section: "http:response", Label: "s:!:Apache:1.x", Sys: "@unix,Windows", Sig: []string{"1:Server,Content-Type,?Content-Length,Date,Connection=[keep-alive]:Keep-Alive,Accept-Ranges:Apache","1:Server,Content-Type,?Content-Length,Date,Connection=[close]:Keep-Alive,Accept-Ranges:Apache"}},
-{Section: "http:response", Label: "s:!:IIS:7.x", Sys: "Windows", Sig: []string{"1:?Content-Length,Content-Type,?Etag,Server,Date:Connection,Keep-Alive,Accept-Ranges:Microsoft-IIS/","1:?Content-Length,Content-Type,?Etag,Server,Date,Connection=[close]:Keep-Alive,Accept-Ranges:Microsoft-IIS/"}},
-{Section: "http:response", Label: "s:!:lighttpd:2.x", Sys: "@unix", Sig: []string{"1:?ETag,?Last-Modified,Accept-Ranges=[bytes],Content-Type,?Vary,?Content-Length,Date,Server:Connection,Keep-Alive:lighttpd/","1:?ETag,?Last-Modified,Transfer-Encoding=[chunked],Content-Type,?Vary,?Content-Length,Date,Server:Connection,Keep-Alive:lighttpd/"}},
-{Section: "http:response", Label: "s:!:lighttpd:1.x", Sys: "@unix", Sig: []string{"1:Content-Type,Accept-Ranges=[bytes],?ETag,?Last-Modified,Date,Server:Connection,Keep-Alive:lighttpd/","1:Content-Type,Transfer-Encoding=[chunked],?ETag,?Last-Modified,Date,Server:Connection,Keep-Alive:lighttpd/","0:Content-Type,Content-Length,Connection=[close],Date,Server:Keep-Alive,Accept-Ranges:lighttpd/"}},
-{Section: "http:response", Label: "s:!:nginx:1.x", Sys: "@unix", Sig: []string{"1:Server,Date,Content-Type,?Content-Length,?Last-Modified,Connection=[keep-alive],Keep-Alive=[timeout],Accept-Ranges=[bytes]::nginx/","1:Server,Date,Content-Type,?Content-Length,?Last-Modified,Connection=[close]:Keep-Alive,Accept-Ranges:nginx/"}},
-{Section: "http:response", Label: "s:!:nginx:0.x", Sys: "@unix", Sig: []string{"1:Server,Date,Content-Type,?Content-Length,Connection=[keep-alive],?Last-Modified:Keep-Alive,Accept-Ranges:nginx/","1:Server,Date,Content-Type,?Content-Length,Connection=[close],?Last-Modified:Keep-Alive,Accept-Ranges:nginx/"}},
-{Section: "http:response", Label: "s:!:tengine:", Sys: "@unix", Sig: []string{"1:Server,Date,Content-Type,Connection=[close],Vary,X-Powered-By,X-Log-Uid,X-Error-Code,PROC_NODE,LB_NODE,Content-Length::Tengine/"}},
-{Section: "http:response", Label: "s:!:pws:8.x", Sys: "@unix", Sig: []string{"1:Date,Server,X-Px,Cache-Control,Expires,Age,Content-Length,Content-Type,Last-Modified,X-Via-CDN,Connection=[close]::PWS"}},
-{Section: "http:response", Label: "s:!:Google Web Server:", Sys: "Linux", Sig: []string{"*:Content-Type,X-Content-Type-Options=[nosniff],Date,Server=[sffe]:Connection,Accept-Ranges,Keep-Alive,Connection:","*:Date,Content-Type,Server=[gws]:Connection,Accept-Ranges,Keep-Alive:","*:Content-Type,X-Content-Type-Options=[nosniff],Server=[GSE]:Connection,Accept-Ranges,Keep-Alive:"}},
+var Data = DB{
+Classes: []string{"win","unix","other"},
+UAOS: []UAMapping{{OS: "Linux", Match: "Linux"},{OS: "Windows", Match: "Windows"},{OS: "iOS", Match: "iPad"},{OS: "iOS", Match: "iPhone"},{OS: "Mac OS X", Match: "Mac OS X"},{OS: "FreeBSD", Match: "FreeBSD"},{OS: "OpenBSD", Match: "OpenBSD"},{OS: "NetBSD", Match: "NetBSD"},{OS: "Solaris", Match: "SunOS"}},
+Entries: []Entry{
+{Section: "mtu", Label: "Ethernet or modem", Sys: nil, Sig: []string{"576","1500"}},
+{Section: "mtu", Label: "DSL", Sys: nil, Sig: []string{"1452","1454","1492"}},
+{Section: "mtu", Label: "GIF", Sys: nil, Sig: []string{"1240","1280"}},
+{Section: "mtu", Label: "generic tunnel or VPN", Sys: nil, Sig: []string{"1300","1400","1420","1440","1450","1460"}},
+{Section: "mtu", Label: "IPSec or GRE", Sys: nil, Sig: []string{"1476"}},
+{Section: "mtu", Label: "IPIP or SIT", Sys: nil, Sig: []string{"1480"}},
+{Section: "mtu", Label: "PPTP", Sys: nil, Sig: []string{"1490"}},
+{Section: "mtu", Label: "AX.25 radio modem", Sys: nil, Sig: []string{"256"}},
+{Section: "mtu", Label: "SLIP", Sys: nil, Sig: []string{"552"}},
+{Section: "mtu", Label: "Google", Sys: nil, Sig: []string{"1470"}},
+{Section: "mtu", Label: "VLAN", Sys: nil, Sig: []string{"1496"}},
+{Section: "mtu", Label: "Ericsson HIS modem", Sys: nil, Sig: []string{"1656"}},
+{Section: "mtu", Label: "jumbo Ethernet", Sys: nil, Sig: []string{"9000"}},
+{Section: "mtu", Label: "loopback", Sys: nil, Sig: []string{"3924","16384","16436"}},
+{Section: "tcp:request", Label: "s:unix:Linux:3.11 and newer", Class: "unix", Name: "Linux", Flavor: "3.11 and newer", Sys: nil, Sig: []string{"*:64:0:*:mss*20,10:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*20,7:mss,sok,ts,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*20,10:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 20, Scale: 10, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*20,7:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 20, Scale: 7, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:Linux:3.1-3.10", Class: "unix", Name: "Linux", Flavor: "3.1-3.10", Sys: nil, Sig: []string{"*:64:0:*:mss*10,4:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*10,5:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*10,6:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*10,7:mss,sok,ts,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*10,4:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 10, Scale: 4, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*10,5:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 10, Scale: 5, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*10,6:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 10, Scale: 6, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*10,7:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 10, Scale: 7, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:Linux:2.6.x", Class: "unix", Name: "Linux", Flavor: "2.6.x", Sys: nil, Sig: []string{"*:64:0:*:mss*4,6:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*4,7:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*4,8:mss,sok,ts,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*4,6:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: 6, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*4,7:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: 7, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*4,8:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: 8, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:Linux:2.4.x", Class: "unix", Name: "Linux", Flavor: "2.4.x", Sys: nil, Sig: []string{"*:64:0:*:mss*4,0:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*4,1:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*4,2:mss,sok,ts,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*4,0:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: 0, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*4,1:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: 1, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*4,2:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: 2, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:Linux:2.2.x", Class: "unix", Name: "Linux", Flavor: "2.2.x", Sys: nil, Sig: []string{"*:64:0:*:mss*11,0:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*20,0:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*22,0:mss,sok,ts,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*11,0:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 11, Scale: 0, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*20,0:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 20, Scale: 0, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*22,0:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 22, Scale: 0, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:Linux:2.0", Class: "unix", Name: "Linux", Flavor: "2.0", Sys: nil, Sig: []string{"*:64:0:*:mss*12,0:mss::0","*:64:0:*:16384,0:mss::0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*12,0:mss::0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 12, Scale: 0, Layout: []string{"mss"}, Quirks: 0, PClass: 0},
+{Raw: "*:64:0:*:16384,0:mss::0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 16384, Scale: 0, Layout: []string{"mss"}, Quirks: 0, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:Linux:3.x (loopback)", Class: "unix", Name: "Linux", Flavor: "3.x (loopback)", Sys: nil, Sig: []string{"*:64:0:16396:mss*2,4:mss,sok,ts,nop,ws:df,id+:0","*:64:0:16376:mss*2,4:mss,sok,ts,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:16396:mss*2,4:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 16396, WinType: WinMSS, Win: 2, Scale: 4, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:16376:mss*2,4:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 16376, WinType: WinMSS, Win: 2, Scale: 4, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:Linux:2.6.x (loopback)", Class: "unix", Name: "Linux", Flavor: "2.6.x (loopback)", Sys: nil, Sig: []string{"*:64:0:16396:mss*2,2:mss,sok,ts,nop,ws:df,id+:0","*:64:0:16376:mss*2,2:mss,sok,ts,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:16396:mss*2,2:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 16396, WinType: WinMSS, Win: 2, Scale: 2, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:16376:mss*2,2:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 16376, WinType: WinMSS, Win: 2, Scale: 2, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:Linux:2.4.x (loopback)", Class: "unix", Name: "Linux", Flavor: "2.4.x (loopback)", Sys: nil, Sig: []string{"*:64:0:16396:mss*2,0:mss,sok,ts,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:16396:mss*2,0:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 16396, WinType: WinMSS, Win: 2, Scale: 0, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:Linux:2.2.x (loopback)", Class: "unix", Name: "Linux", Flavor: "2.2.x (loopback)", Sys: nil, Sig: []string{"*:64:0:3884:mss*8,0:mss,sok,ts,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:3884:mss*8,0:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 3884, WinType: WinMSS, Win: 8, Scale: 0, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:Linux:2.6.x (Google crawler)", Class: "unix", Name: "Linux", Flavor: "2.6.x (Google crawler)", Sys: nil, Sig: []string{"4:64:0:1430:mss*4,6:mss,sok,ts,nop,ws::0"},
+TCP: []Signature{
+{Raw: "4:64:0:1430:mss*4,6:mss,sok,ts,nop,ws::0", Version: 4, TTL: 64, OLen: 0, MSS: 1430, WinType: WinMSS, Win: 4, Scale: 6, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: 0, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:Linux:(Android)", Class: "unix", Name: "Linux", Flavor: "(Android)", Sys: nil, Sig: []string{"*:64:0:*:mss*44,1:mss,sok,ts,nop,ws:df,id+:0","*:64:0:*:mss*44,3:mss,sok,ts,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*44,1:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 44, Scale: 1, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*44,3:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 44, Scale: 3, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "g:unix:Linux:3.x", Generic: true, Class: "unix", Name: "Linux", Flavor: "3.x", Sys: nil, Sig: []string{"*:64:0:*:mss*10,*:mss,sok,ts,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*10,*:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 10, Scale: Any, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "g:unix:Linux:2.4.x-2.6.x", Generic: true, Class: "unix", Name: "Linux", Flavor: "2.4.x-2.6.x", Sys: nil, Sig: []string{"*:64:0:*:mss*4,*:mss,sok,ts,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*4,*:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: Any, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "g:unix:Linux:2.2.x-3.x", Generic: true, Class: "unix", Name: "Linux", Flavor: "2.2.x-3.x", Sys: nil, Sig: []string{"*:64:0:*:*,*:mss,sok,ts,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:*,*:mss,sok,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinAny, Win: 0, Scale: Any, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "g:unix:Linux:2.2.x-3.x (no timestamps)", Generic: true, Class: "unix", Name: "Linux", Flavor: "2.2.x-3.x (no timestamps)", Sys: nil, Sig: []string{"*:64:0:*:*,*:mss,nop,nop,sok,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:*,*:mss,nop,nop,sok,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinAny, Win: 0, Scale: Any, Layout: []string{"mss","nop","nop","sok","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "g:unix:Linux:2.2.x-3.x (barebone)", Generic: true, Class: "unix", Name: "Linux", Flavor: "2.2.x-3.x (barebone)", Sys: nil, Sig: []string{"*:64:0:*:*,0:mss:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:*,0:mss:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinAny, Win: 0, Scale: 0, Layout: []string{"mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:win:Windows:XP", Class: "win", Name: "Windows", Flavor: "XP", Sys: nil, Sig: []string{"*:128:0:*:16384,0:mss,nop,nop,sok:df,id+:0","*:128:0:*:65535,0:mss,nop,nop,sok:df,id+:0","*:128:0:*:65535,0:mss,nop,ws,nop,nop,sok:df,id+:0","*:128:0:*:65535,1:mss,nop,ws,nop,nop,sok:df,id+:0","*:128:0:*:65535,2:mss,nop,ws,nop,nop,sok:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:128:0:*:16384,0:mss,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 16384, Scale: 0, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:65535,0:mss,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:65535,0:mss,nop,ws,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","ws","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:65535,1:mss,nop,ws,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 1, Layout: []string{"mss","nop","ws","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:65535,2:mss,nop,ws,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 2, Layout: []string{"mss","nop","ws","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:win:Windows:7 or 8", Class: "win", Name: "Windows", Flavor: "7 or 8", Sys: nil, Sig: []string{"*:128:0:*:8192,0:mss,nop,nop,sok:df,id+:0","*:128:0:*:8192,2:mss,nop,ws,nop,nop,sok:df,id+:0","*:128:0:*:8192,8:mss,nop,ws,nop,nop,sok:df,id+:0","*:128:0:*:8192,2:mss,nop,ws,sok,ts:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:128:0:*:8192,0:mss,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 8192, Scale: 0, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:8192,2:mss,nop,ws,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 8192, Scale: 2, Layout: []string{"mss","nop","ws","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:8192,8:mss,nop,ws,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 8192, Scale: 8, Layout: []string{"mss","nop","ws","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:8192,2:mss,nop,ws,sok,ts:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 8192, Scale: 2, Layout: []string{"mss","nop","ws","sok","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:win:Windows:7 (Websense crawler)", Class: "win", Name: "Windows", Flavor: "7 (Websense crawler)", Sys: nil, Sig: []string{"*:64:0:1380:mss*4,6:mss,nop,nop,ts,nop,ws:df,id+:0","*:64:0:1380:mss*4,7:mss,nop,nop,ts,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:1380:mss*4,6:mss,nop,nop,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 1380, WinType: WinMSS, Win: 4, Scale: 6, Layout: []string{"mss","nop","nop","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:1380:mss*4,7:mss,nop,nop,ts,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 1380, WinType: WinMSS, Win: 4, Scale: 7, Layout: []string{"mss","nop","nop","ts","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "g:win:Windows:NT kernel 5.x", Generic: true, Class: "win", Name: "Windows", Flavor: "NT kernel 5.x", Sys: nil, Sig: []string{"*:128:0:*:16384,*:mss,nop,nop,sok:df,id+:0","*:128:0:*:65535,*:mss,nop,nop,sok:df,id+:0","*:128:0:*:16384,*:mss,nop,ws,nop,nop,sok:df,id+:0","*:128:0:*:65535,*:mss,nop,ws,nop,nop,sok:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:128:0:*:16384,*:mss,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 16384, Scale: Any, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:65535,*:mss,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: Any, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:16384,*:mss,nop,ws,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 16384, Scale: Any, Layout: []string{"mss","nop","ws","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:65535,*:mss,nop,ws,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: Any, Layout: []string{"mss","nop","ws","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "g:win:Windows:NT kernel 6.x", Generic: true, Class: "win", Name: "Windows", Flavor: "NT kernel 6.x", Sys: nil, Sig: []string{"*:128:0:*:8192,*:mss,nop,nop,sok:df,id+:0","*:128:0:*:8192,*:mss,nop,ws,nop,nop,sok:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:128:0:*:8192,*:mss,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 8192, Scale: Any, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:8192,*:mss,nop,ws,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 8192, Scale: Any, Layout: []string{"mss","nop","ws","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "g:win:Windows:NT kernel", Generic: true, Class: "win", Name: "Windows", Flavor: "NT kernel", Sys: nil, Sig: []string{"*:128:0:*:*,*:mss,nop,nop,sok:df,id+:0","*:128:0:*:*,*:mss,nop,ws,nop,nop,sok:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:128:0:*:*,*:mss,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinAny, Win: 0, Scale: Any, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:*,*:mss,nop,ws,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinAny, Win: 0, Scale: Any, Layout: []string{"mss","nop","ws","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:Mac OS X:10.x", Class: "unix", Name: "Mac OS X", Flavor: "10.x", Sys: nil, Sig: []string{"*:64:0:*:65535,1:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0","*:64:0:*:65535,3:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:65535,1:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 1, Layout: []string{"mss","nop","ws","nop","nop","ts","sok","eol+1"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:65535,3:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 3, Layout: []string{"mss","nop","ws","nop","nop","ts","sok","eol+1"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:MacOS X:10.9 or newer (sometimes iPhone or iPad)", Class: "unix", Name: "MacOS X", Flavor: "10.9 or newer (sometimes iPhone or iPad)", Sys: nil, Sig: []string{"*:64:0:*:65535,4:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:65535,4:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 4, Layout: []string{"mss","nop","ws","nop","nop","ts","sok","eol+1"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:iOS:iPhone or iPad", Class: "unix", Name: "iOS", Flavor: "iPhone or iPad", Sys: nil, Sig: []string{"*:64:0:*:65535,2:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:65535,2:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 2, Layout: []string{"mss","nop","ws","nop","nop","ts","sok","eol+1"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "g:unix:Mac OS X:", Generic: true, Class: "unix", Name: "Mac OS X", Flavor: "", Sys: nil, Sig: []string{"*:64:0:*:65535,*:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:65535,*:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: Any, Layout: []string{"mss","nop","ws","nop","nop","ts","sok","eol+1"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:FreeBSD:9.x or newer", Class: "unix", Name: "FreeBSD", Flavor: "9.x or newer", Sys: nil, Sig: []string{"*:64:0:*:65535,6:mss,nop,ws,sok,ts:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:65535,6:mss,nop,ws,sok,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 6, Layout: []string{"mss","nop","ws","sok","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:FreeBSD:8.x", Class: "unix", Name: "FreeBSD", Flavor: "8.x", Sys: nil, Sig: []string{"*:64:0:*:65535,3:mss,nop,ws,sok,ts:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:65535,3:mss,nop,ws,sok,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 3, Layout: []string{"mss","nop","ws","sok","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "g:unix:FreeBSD:", Generic: true, Class: "unix", Name: "FreeBSD", Flavor: "", Sys: nil, Sig: []string{"*:64:0:*:65535,*:mss,nop,ws,sok,ts:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:65535,*:mss,nop,ws,sok,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: Any, Layout: []string{"mss","nop","ws","sok","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:OpenBSD:3.x", Class: "unix", Name: "OpenBSD", Flavor: "3.x", Sys: nil, Sig: []string{"*:64:0:*:16384,0:mss,nop,nop,sok,nop,ws,nop,nop,ts:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:16384,0:mss,nop,nop,sok,nop,ws,nop,nop,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 16384, Scale: 0, Layout: []string{"mss","nop","nop","sok","nop","ws","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:OpenBSD:4.x-5.x", Class: "unix", Name: "OpenBSD", Flavor: "4.x-5.x", Sys: nil, Sig: []string{"*:64:0:*:16384,3:mss,nop,nop,sok,nop,ws,nop,nop,ts:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:16384,3:mss,nop,nop,sok,nop,ws,nop,nop,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 16384, Scale: 3, Layout: []string{"mss","nop","nop","sok","nop","ws","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:Solaris:8", Class: "unix", Name: "Solaris", Flavor: "8", Sys: nil, Sig: []string{"*:64:0:*:32850,1:nop,ws,nop,nop,ts,nop,nop,sok,mss:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:32850,1:nop,ws,nop,nop,ts,nop,nop,sok,mss:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 32850, Scale: 1, Layout: []string{"nop","ws","nop","nop","ts","nop","nop","sok","mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:Solaris:10", Class: "unix", Name: "Solaris", Flavor: "10", Sys: nil, Sig: []string{"*:64:0:*:mss*34,0:mss,nop,ws,nop,nop,sok:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*34,0:mss,nop,ws,nop,nop,sok:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 34, Scale: 0, Layout: []string{"mss","nop","ws","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:OpenVMS:8.x", Class: "unix", Name: "OpenVMS", Flavor: "8.x", Sys: nil, Sig: []string{"4:128:0:1460:mtu*2,0:mss,nop,ws::0"},
+TCP: []Signature{
+{Raw: "4:128:0:1460:mtu*2,0:mss,nop,ws::0", Version: 4, TTL: 128, OLen: 0, MSS: 1460, WinType: WinMTU, Win: 2, Scale: 0, Layout: []string{"mss","nop","ws"}, Quirks: 0, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:OpenVMS:7.x", Class: "unix", Name: "OpenVMS", Flavor: "7.x", Sys: nil, Sig: []string{"4:64:0:1460:61440,0:mss,nop,ws::0"},
+TCP: []Signature{
+{Raw: "4:64:0:1460:61440,0:mss,nop,ws::0", Version: 4, TTL: 64, OLen: 0, MSS: 1460, WinType: WinValue, Win: 61440, Scale: 0, Layout: []string{"mss","nop","ws"}, Quirks: 0, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:other:NeXTSTEP:", Class: "other", Name: "NeXTSTEP", Flavor: "", Sys: nil, Sig: []string{"4:64:0:1024:mss*4,0:mss::0"},
+TCP: []Signature{
+{Raw: "4:64:0:1024:mss*4,0:mss::0", Version: 4, TTL: 64, OLen: 0, MSS: 1024, WinType: WinMSS, Win: 4, Scale: 0, Layout: []string{"mss"}, Quirks: 0, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:Tru64:4.x", Class: "unix", Name: "Tru64", Flavor: "4.x", Sys: nil, Sig: []string{"4:64:0:1460:32768,0:mss,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "4:64:0:1460:32768,0:mss,nop,ws:df,id+:0", Version: 4, TTL: 64, OLen: 0, MSS: 1460, WinType: WinValue, Win: 32768, Scale: 0, Layout: []string{"mss","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:!:NMap:SYN scan", Class: "!", Name: "NMap", Flavor: "SYN scan", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX","Windows"}, Sig: []string{"*:64-:0:1460:1024,0:mss::0","*:64-:0:1460:2048,0:mss::0","*:64-:0:1460:3072,0:mss::0","*:64-:0:1460:4096,0:mss::0"},
+TCP: []Signature{
+{Raw: "*:64-:0:1460:1024,0:mss::0", Version: Any, TTL: 64, BadTTL: true, OLen: 0, MSS: 1460, WinType: WinValue, Win: 1024, Scale: 0, Layout: []string{"mss"}, Quirks: 0, PClass: 0},
+{Raw: "*:64-:0:1460:2048,0:mss::0", Version: Any, TTL: 64, BadTTL: true, OLen: 0, MSS: 1460, WinType: WinValue, Win: 2048, Scale: 0, Layout: []string{"mss"}, Quirks: 0, PClass: 0},
+{Raw: "*:64-:0:1460:3072,0:mss::0", Version: Any, TTL: 64, BadTTL: true, OLen: 0, MSS: 1460, WinType: WinValue, Win: 3072, Scale: 0, Layout: []string{"mss"}, Quirks: 0, PClass: 0},
+{Raw: "*:64-:0:1460:4096,0:mss::0", Version: Any, TTL: 64, BadTTL: true, OLen: 0, MSS: 1460, WinType: WinValue, Win: 4096, Scale: 0, Layout: []string{"mss"}, Quirks: 0, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:!:NMap:OS detection", Class: "!", Name: "NMap", Flavor: "OS detection", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX","Windows"}, Sig: []string{"*:64-:0:265:512,0:mss,sok,ts:ack+:0","*:64-:0:0:4,10:sok,ts,ws,eol+0:ack+:0","*:64-:0:1460:1,10:ws,nop,mss,ts,sok:ack+:0","*:64-:0:536:16,10:mss,sok,ts,ws,eol+0:ack+:0","*:64-:0:640:4,5:ts,nop,nop,ws,nop,mss:ack+:0","*:64-:0:1400:63,0:mss,ws,sok,ts,eol+0:ack+:0","*:64-:0:265:31337,10:ws,nop,mss,ts,sok:ack+:0","*:64-:0:1460:3,10:ws,nop,mss,sok,nop,nop:ecn,uptr+:0"},
+TCP: []Signature{
+{Raw: "*:64-:0:265:512,0:mss,sok,ts:ack+:0", Version: Any, TTL: 64, BadTTL: true, OLen: 0, MSS: 265, WinType: WinValue, Win: 512, Scale: 0, Layout: []string{"mss","sok","ts"}, Quirks: QuirkNonZeroAck, PClass: 0},
+{Raw: "*:64-:0:0:4,10:sok,ts,ws,eol+0:ack+:0", Version: Any, TTL: 64, BadTTL: true, OLen: 0, MSS: 0, WinType: WinValue, Win: 4, Scale: 10, Layout: []string{"sok","ts","ws","eol+0"}, Quirks: QuirkNonZeroAck, PClass: 0},
+{Raw: "*:64-:0:1460:1,10:ws,nop,mss,ts,sok:ack+:0", Version: Any, TTL: 64, BadTTL: true, OLen: 0, MSS: 1460, WinType: WinValue, Win: 1, Scale: 10, Layout: []string{"ws","nop","mss","ts","sok"}, Quirks: QuirkNonZeroAck, PClass: 0},
+{Raw: "*:64-:0:536:16,10:mss,sok,ts,ws,eol+0:ack+:0", Version: Any, TTL: 64, BadTTL: true, OLen: 0, MSS: 536, WinType: WinValue, Win: 16, Scale: 10, Layout: []string{"mss","sok","ts","ws","eol+0"}, Quirks: QuirkNonZeroAck, PClass: 0},
+{Raw: "*:64-:0:640:4,5:ts,nop,nop,ws,nop,mss:ack+:0", Version: Any, TTL: 64, BadTTL: true, OLen: 0, MSS: 640, WinType: WinValue, Win: 4, Scale: 5, Layout: []string{"ts","nop","nop","ws","nop","mss"}, Quirks: QuirkNonZeroAck, PClass: 0},
+{Raw: "*:64-:0:1400:63,0:mss,ws,sok,ts,eol+0:ack+:0", Version: Any, TTL: 64, BadTTL: true, OLen: 0, MSS: 1400, WinType: WinValue, Win: 63, Scale: 0, Layout: []string{"mss","ws","sok","ts","eol+0"}, Quirks: QuirkNonZeroAck, PClass: 0},
+{Raw: "*:64-:0:265:31337,10:ws,nop,mss,ts,sok:ack+:0", Version: Any, TTL: 64, BadTTL: true, OLen: 0, MSS: 265, WinType: WinValue, Win: 31337, Scale: 10, Layout: []string{"ws","nop","mss","ts","sok"}, Quirks: QuirkNonZeroAck, PClass: 0},
+{Raw: "*:64-:0:1460:3,10:ws,nop,mss,sok,nop,nop:ecn,uptr+:0", Version: Any, TTL: 64, BadTTL: true, OLen: 0, MSS: 1460, WinType: WinValue, Win: 3, Scale: 10, Layout: []string{"ws","nop","mss","sok","nop","nop"}, Quirks: QuirkECN|QuirkNonZeroURG, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:p0f:sendsyn utility", Class: "unix", Name: "p0f", Flavor: "sendsyn utility", Sys: nil, Sig: []string{"*:192:0:1331:1337,0:mss,nop,eol+18::0","*:192:0:1331:1337,0:mss,ts,nop,eol+8::0","*:192:0:1331:1337,5:mss,ws,nop,eol+15::0","*:192:0:1331:1337,0:mss,sok,nop,eol+16::0","*:192:0:1331:1337,5:mss,ws,ts,nop,eol+5::0","*:192:0:1331:1337,0:mss,sok,ts,nop,eol+6::0","*:192:0:1331:1337,5:mss,ws,sok,nop,eol+13::0","*:192:0:1331:1337,5:mss,ws,sok,ts,nop,eol+3::0"},
+TCP: []Signature{
+{Raw: "*:192:0:1331:1337,0:mss,nop,eol+18::0", Version: Any, TTL: 192, OLen: 0, MSS: 1331, WinType: WinValue, Win: 1337, Scale: 0, Layout: []string{"mss","nop","eol+18"}, Quirks: 0, PClass: 0},
+{Raw: "*:192:0:1331:1337,0:mss,ts,nop,eol+8::0", Version: Any, TTL: 192, OLen: 0, MSS: 1331, WinType: WinValue, Win: 1337, Scale: 0, Layout: []string{"mss","ts","nop","eol+8"}, Quirks: 0, PClass: 0},
+{Raw: "*:192:0:1331:1337,5:mss,ws,nop,eol+15::0", Version: Any, TTL: 192, OLen: 0, MSS: 1331, WinType: WinValue, Win: 1337, Scale: 5, Layout: []string{"mss","ws","nop","eol+15"}, Quirks: 0, PClass: 0},
+{Raw: "*:192:0:1331:1337,0:mss,sok,nop,eol+16::0", Version: Any, TTL: 192, OLen: 0, MSS: 1331, WinType: WinValue, Win: 1337, Scale: 0, Layout: []string{"mss","sok","nop","eol+16"}, Quirks: 0, PClass: 0},
+{Raw: "*:192:0:1331:1337,5:mss,ws,ts,nop,eol+5::0", Version: Any, TTL: 192, OLen: 0, MSS: 1331, WinType: WinValue, Win: 1337, Scale: 5, Layout: []string{"mss","ws","ts","nop","eol+5"}, Quirks: 0, PClass: 0},
+{Raw: "*:192:0:1331:1337,0:mss,sok,ts,nop,eol+6::0", Version: Any, TTL: 192, OLen: 0, MSS: 1331, WinType: WinValue, Win: 1337, Scale: 0, Layout: []string{"mss","sok","ts","nop","eol+6"}, Quirks: 0, PClass: 0},
+{Raw: "*:192:0:1331:1337,5:mss,ws,sok,nop,eol+13::0", Version: Any, TTL: 192, OLen: 0, MSS: 1331, WinType: WinValue, Win: 1337, Scale: 5, Layout: []string{"mss","ws","sok","nop","eol+13"}, Quirks: 0, PClass: 0},
+{Raw: "*:192:0:1331:1337,5:mss,ws,sok,ts,nop,eol+3::0", Version: Any, TTL: 192, OLen: 0, MSS: 1331, WinType: WinValue, Win: 1337, Scale: 5, Layout: []string{"mss","ws","sok","ts","nop","eol+3"}, Quirks: 0, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:other:Blackberry:", Class: "other", Name: "Blackberry", Flavor: "", Sys: nil, Sig: []string{"*:128:0:1452:65535,0:mss,nop,nop,sok,nop,nop,ts::0"},
+TCP: []Signature{
+{Raw: "*:128:0:1452:65535,0:mss,nop,nop,sok,nop,nop,ts::0", Version: Any, TTL: 128, OLen: 0, MSS: 1452, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","nop","sok","nop","nop","ts"}, Quirks: 0, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:other:Nintendo:3DS", Class: "other", Name: "Nintendo", Flavor: "3DS", Sys: nil, Sig: []string{"*:64:0:1360:32768,0:mss,nop,nop,sok:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:1360:32768,0:mss,nop,nop,sok:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 1360, WinType: WinValue, Win: 32768, Scale: 0, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:other:Nintendo:Wii", Class: "other", Name: "Nintendo", Flavor: "Wii", Sys: nil, Sig: []string{"4:64:0:1460:32768,0:mss,nop,nop,sok:df,id+:0"},
+TCP: []Signature{
+{Raw: "4:64:0:1460:32768,0:mss,nop,nop,sok:df,id+:0", Version: 4, TTL: 64, OLen: 0, MSS: 1460, WinType: WinValue, Win: 32768, Scale: 0, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:request", Label: "s:unix:BaiduSpider:", Class: "unix", Name: "BaiduSpider", Flavor: "", Sys: nil, Sig: []string{"*:64:0:1460:mss*4,7:mss,sok,nop,nop,nop,nop,nop,nop,nop,nop,nop,nop,nop,ws:df,id+:0","*:64:0:1460:mss*4,2:mss,sok,nop,nop,nop,nop,nop,nop,nop,nop,nop,nop,nop,ws:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:1460:mss*4,7:mss,sok,nop,nop,nop,nop,nop,nop,nop,nop,nop,nop,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 1460, WinType: WinMSS, Win: 4, Scale: 7, Layout: []string{"mss","sok","nop","nop","nop","nop","nop","nop","nop","nop","nop","nop","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:1460:mss*4,2:mss,sok,nop,nop,nop,nop,nop,nop,nop,nop,nop,nop,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 1460, WinType: WinMSS, Win: 4, Scale: 2, Layout: []string{"mss","sok","nop","nop","nop","nop","nop","nop","nop","nop","nop","nop","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:unix:Linux:3.x", Class: "unix", Name: "Linux", Flavor: "3.x", Sys: nil, Sig: []string{"*:64:0:*:mss*10,0:mss:df:0","*:64:0:*:mss*10,0:mss,sok,ts:df:0","*:64:0:*:mss*10,0:mss,nop,nop,ts:df:0","*:64:0:*:mss*10,0:mss,nop,nop,sok:df:0","*:64:0:*:mss*10,*:mss,nop,ws:df:0","*:64:0:*:mss*10,*:mss,sok,ts,nop,ws:df:0","*:64:0:*:mss*10,*:mss,nop,nop,ts,nop,ws:df:0","*:64:0:*:mss*10,*:mss,nop,nop,sok,nop,ws:df:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*10,0:mss:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 10, Scale: 0, Layout: []string{"mss"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*10,0:mss,sok,ts:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 10, Scale: 0, Layout: []string{"mss","sok","ts"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*10,0:mss,nop,nop,ts:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 10, Scale: 0, Layout: []string{"mss","nop","nop","ts"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*10,0:mss,nop,nop,sok:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 10, Scale: 0, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*10,*:mss,nop,ws:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 10, Scale: Any, Layout: []string{"mss","nop","ws"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*10,*:mss,sok,ts,nop,ws:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 10, Scale: Any, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*10,*:mss,nop,nop,ts,nop,ws:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 10, Scale: Any, Layout: []string{"mss","nop","nop","ts","nop","ws"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*10,*:mss,nop,nop,sok,nop,ws:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 10, Scale: Any, Layout: []string{"mss","nop","nop","sok","nop","ws"}, Quirks: QuirkDF, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:unix:Linux:2.4-2.6", Class: "unix", Name: "Linux", Flavor: "2.4-2.6", Sys: nil, Sig: []string{"*:64:0:*:mss*4,0:mss:df:0","*:64:0:*:mss*4,0:mss,sok,ts:df:0","*:64:0:*:mss*4,0:mss,nop,nop,ts:df:0","*:64:0:*:mss*4,0:mss,nop,nop,sok:df:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*4,0:mss:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: 0, Layout: []string{"mss"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*4,0:mss,sok,ts:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: 0, Layout: []string{"mss","sok","ts"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*4,0:mss,nop,nop,ts:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: 0, Layout: []string{"mss","nop","nop","ts"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*4,0:mss,nop,nop,sok:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: 0, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:unix:Linux:2.4.x", Class: "unix", Name: "Linux", Flavor: "2.4.x", Sys: nil, Sig: []string{"*:64:0:*:mss*4,0:mss,nop,ws:df:0","*:64:0:*:mss*4,0:mss,sok,ts,nop,ws:df:0","*:64:0:*:mss*4,0:mss,nop,nop,ts,nop,ws:df:0","*:64:0:*:mss*4,0:mss,nop,nop,sok,nop,ws:df:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*4,0:mss,nop,ws:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: 0, Layout: []string{"mss","nop","ws"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*4,0:mss,sok,ts,nop,ws:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: 0, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*4,0:mss,nop,nop,ts,nop,ws:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: 0, Layout: []string{"mss","nop","nop","ts","nop","ws"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*4,0:mss,nop,nop,sok,nop,ws:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: 0, Layout: []string{"mss","nop","nop","sok","nop","ws"}, Quirks: QuirkDF, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:unix:Linux:2.6.x", Class: "unix", Name: "Linux", Flavor: "2.6.x", Sys: nil, Sig: []string{"*:64:0:*:mss*4,*:mss,nop,ws:df:0","*:64:0:*:mss*4,*:mss,sok,ts,nop,ws:df:0","*:64:0:*:mss*4,*:mss,nop,nop,ts,nop,ws:df:0","*:64:0:*:mss*4,*:mss,nop,nop,sok,nop,ws:df:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*4,*:mss,nop,ws:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: Any, Layout: []string{"mss","nop","ws"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*4,*:mss,sok,ts,nop,ws:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: Any, Layout: []string{"mss","sok","ts","nop","ws"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*4,*:mss,nop,nop,ts,nop,ws:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: Any, Layout: []string{"mss","nop","nop","ts","nop","ws"}, Quirks: QuirkDF, PClass: 0},
+{Raw: "*:64:0:*:mss*4,*:mss,nop,nop,sok,nop,ws:df:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 4, Scale: Any, Layout: []string{"mss","nop","nop","sok","nop","ws"}, Quirks: QuirkDF, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:win:Windows:XP", Class: "win", Name: "Windows", Flavor: "XP", Sys: nil, Sig: []string{"*:128:0:*:65535,0:mss:df,id+:0","*:128:0:*:65535,0:mss,nop,ws:df,id+:0","*:128:0:*:65535,0:mss,nop,nop,sok:df,id+:0","*:128:0:*:65535,0:mss,nop,nop,ts:df,id+,ts1-:0","*:128:0:*:65535,0:mss,nop,ws,nop,nop,sok:df,id+:0","*:128:0:*:65535,0:mss,nop,ws,nop,nop,ts:df,id+,ts1-:0","*:128:0:*:65535,0:mss,nop,nop,ts,nop,nop,sok:df,id+,ts1-:0","*:128:0:*:65535,0:mss,nop,ws,nop,nop,ts,nop,nop,sok:df,id+,ts1-:0","*:128:0:*:16384,0:mss:df,id+:0","*:128:0:*:16384,0:mss,nop,ws:df,id+:0","*:128:0:*:16384,0:mss,nop,nop,sok:df,id+:0","*:128:0:*:16384,0:mss,nop,nop,ts:df,id+,ts1-:0","*:128:0:*:16384,0:mss,nop,ws,nop,nop,sok:df,id+:0","*:128:0:*:16384,0:mss,nop,ws,nop,nop,ts:df,id+,ts1-:0","*:128:0:*:16384,0:mss,nop,nop,ts,nop,nop,sok:df,id+,ts1-:0","*:128:0:*:16384,0:mss,nop,ws,nop,nop,ts,nop,nop,sok:df,id+,ts1-:0"},
+TCP: []Signature{
+{Raw: "*:128:0:*:65535,0:mss:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:65535,0:mss,nop,ws:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:65535,0:mss,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:65535,0:mss,nop,nop,ts:df,id+,ts1-:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID|QuirkZeroTS1, PClass: 0},
+{Raw: "*:128:0:*:65535,0:mss,nop,ws,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","ws","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:65535,0:mss,nop,ws,nop,nop,ts:df,id+,ts1-:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","ws","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID|QuirkZeroTS1, PClass: 0},
+{Raw: "*:128:0:*:65535,0:mss,nop,nop,ts,nop,nop,sok:df,id+,ts1-:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","nop","ts","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID|QuirkZeroTS1, PClass: 0},
+{Raw: "*:128:0:*:65535,0:mss,nop,ws,nop,nop,ts,nop,nop,sok:df,id+,ts1-:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","ws","nop","nop","ts","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID|QuirkZeroTS1, PClass: 0},
+{Raw: "*:128:0:*:16384,0:mss:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 16384, Scale: 0, Layout: []string{"mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:16384,0:mss,nop,ws:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 16384, Scale: 0, Layout: []string{"mss","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:16384,0:mss,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 16384, Scale: 0, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:16384,0:mss,nop,nop,ts:df,id+,ts1-:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 16384, Scale: 0, Layout: []string{"mss","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID|QuirkZeroTS1, PClass: 0},
+{Raw: "*:128:0:*:16384,0:mss,nop,ws,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 16384, Scale: 0, Layout: []string{"mss","nop","ws","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:16384,0:mss,nop,ws,nop,nop,ts:df,id+,ts1-:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 16384, Scale: 0, Layout: []string{"mss","nop","ws","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID|QuirkZeroTS1, PClass: 0},
+{Raw: "*:128:0:*:16384,0:mss,nop,nop,ts,nop,nop,sok:df,id+,ts1-:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 16384, Scale: 0, Layout: []string{"mss","nop","nop","ts","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID|QuirkZeroTS1, PClass: 0},
+{Raw: "*:128:0:*:16384,0:mss,nop,ws,nop,nop,ts,nop,nop,sok:df,id+,ts1-:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 16384, Scale: 0, Layout: []string{"mss","nop","ws","nop","nop","ts","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID|QuirkZeroTS1, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:win:Windows:7 or 8", Class: "win", Name: "Windows", Flavor: "7 or 8", Sys: nil, Sig: []string{"*:128:0:*:8192,0:mss:df,id+:0","*:128:0:*:8192,0:mss,sok,ts:df,id+:0","*:128:0:*:8192,8:mss,nop,ws:df,id+:0","*:128:0:*:8192,0:mss,nop,nop,ts:df,id+:0","*:128:0:*:8192,0:mss,nop,nop,sok:df,id+:0","*:128:0:*:8192,8:mss,nop,ws,sok,ts:df,id+:0","*:128:0:*:8192,8:mss,nop,ws,nop,nop,ts:df,id+:0","*:128:0:*:8192,8:mss,nop,ws,nop,nop,sok:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:128:0:*:8192,0:mss:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 8192, Scale: 0, Layout: []string{"mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:8192,0:mss,sok,ts:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 8192, Scale: 0, Layout: []string{"mss","sok","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:8192,8:mss,nop,ws:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 8192, Scale: 8, Layout: []string{"mss","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:8192,0:mss,nop,nop,ts:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 8192, Scale: 0, Layout: []string{"mss","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:8192,0:mss,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 8192, Scale: 0, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:8192,8:mss,nop,ws,sok,ts:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 8192, Scale: 8, Layout: []string{"mss","nop","ws","sok","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:8192,8:mss,nop,ws,nop,nop,ts:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 8192, Scale: 8, Layout: []string{"mss","nop","ws","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:128:0:*:8192,8:mss,nop,ws,nop,nop,sok:df,id+:0", Version: Any, TTL: 128, OLen: 0, MSS: Any, WinType: WinValue, Win: 8192, Scale: 8, Layout: []string{"mss","nop","ws","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:unix:FreeBSD:9.x", Class: "unix", Name: "FreeBSD", Flavor: "9.x", Sys: nil, Sig: []string{"*:64:0:*:65535,6:mss,nop,ws:df,id+:0","*:64:0:*:65535,6:mss,nop,ws,sok,ts:df,id+:0","*:64:0:*:65535,6:mss,nop,ws,sok,eol+1:df,id+:0","*:64:0:*:65535,6:mss,nop,ws,nop,nop,ts:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:65535,6:mss,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 6, Layout: []string{"mss","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:65535,6:mss,nop,ws,sok,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 6, Layout: []string{"mss","nop","ws","sok","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:65535,6:mss,nop,ws,sok,eol+1:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 6, Layout: []string{"mss","nop","ws","sok","eol+1"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:65535,6:mss,nop,ws,nop,nop,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 6, Layout: []string{"mss","nop","ws","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:unix:FreeBSD:8.x", Class: "unix", Name: "FreeBSD", Flavor: "8.x", Sys: nil, Sig: []string{"*:64:0:*:65535,3:mss,nop,ws:df,id+:0","*:64:0:*:65535,3:mss,nop,ws,sok,ts:df,id+:0","*:64:0:*:65535,3:mss,nop,ws,sok,eol+1:df,id+:0","*:64:0:*:65535,3:mss,nop,ws,nop,nop,ts:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:65535,3:mss,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 3, Layout: []string{"mss","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:65535,3:mss,nop,ws,sok,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 3, Layout: []string{"mss","nop","ws","sok","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:65535,3:mss,nop,ws,sok,eol+1:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 3, Layout: []string{"mss","nop","ws","sok","eol+1"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:65535,3:mss,nop,ws,nop,nop,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 3, Layout: []string{"mss","nop","ws","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:unix:FreeBSD:8.x-9.x", Class: "unix", Name: "FreeBSD", Flavor: "8.x-9.x", Sys: nil, Sig: []string{"*:64:0:*:65535,0:mss,sok,ts:df,id+:0","*:64:0:*:65535,0:mss,sok,eol+1:df,id+:0","*:64:0:*:65535,0:mss,nop,nop,ts:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:65535,0:mss,sok,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","sok","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:65535,0:mss,sok,eol+1:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","sok","eol+1"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:65535,0:mss,nop,nop,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:unix:OpenBSD:5.x", Class: "unix", Name: "OpenBSD", Flavor: "5.x", Sys: nil, Sig: []string{"*:64:0:1460:16384,0:mss,nop,nop,sok:df,id+:0","*:64:0:1460:16384,3:mss,nop,ws:df,id+:0","*:64:0:1460:16384,3:mss,nop,nop,sok,nop,ws:df,id+:0","*:64:0:1460:16384,0:mss,nop,nop,ts:df,id+:0","*:64:0:1460:16384,0:mss,nop,nop,sok,nop,nop,ts:df,id+:0","*:64:0:1460:16384,3:mss,nop,ws,nop,nop,ts:df,id+:0","*:64:0:1460:16384,3:mss,nop,nop,sok,nop,ws,nop,nop,ts:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:1460:16384,0:mss,nop,nop,sok:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 1460, WinType: WinValue, Win: 16384, Scale: 0, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:1460:16384,3:mss,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 1460, WinType: WinValue, Win: 16384, Scale: 3, Layout: []string{"mss","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:1460:16384,3:mss,nop,nop,sok,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 1460, WinType: WinValue, Win: 16384, Scale: 3, Layout: []string{"mss","nop","nop","sok","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:1460:16384,0:mss,nop,nop,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 1460, WinType: WinValue, Win: 16384, Scale: 0, Layout: []string{"mss","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:1460:16384,0:mss,nop,nop,sok,nop,nop,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 1460, WinType: WinValue, Win: 16384, Scale: 0, Layout: []string{"mss","nop","nop","sok","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:1460:16384,3:mss,nop,ws,nop,nop,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 1460, WinType: WinValue, Win: 16384, Scale: 3, Layout: []string{"mss","nop","ws","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:1460:16384,3:mss,nop,nop,sok,nop,ws,nop,nop,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: 1460, WinType: WinValue, Win: 16384, Scale: 3, Layout: []string{"mss","nop","nop","sok","nop","ws","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:unix:Mac OS X:10.x", Class: "unix", Name: "Mac OS X", Flavor: "10.x", Sys: nil, Sig: []string{"*:64:0:*:65535,0:mss,nop,ws:df,id+:0","*:64:0:*:65535,0:mss,sok,eol+1:df,id+:0","*:64:0:*:65535,0:mss,nop,nop,ts:df,id+:0","*:64:0:*:65535,0:mss,nop,ws,sok,eol+1:df,id+:0","*:64:0:*:65535,0:mss,nop,ws,nop,nop,ts:df,id+:0","*:64:0:*:65535,0:mss,nop,nop,ts,sok,eol+1:df,id+:0","*:64:0:*:65535,0:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:65535,0:mss,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:65535,0:mss,sok,eol+1:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","sok","eol+1"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:65535,0:mss,nop,nop,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:65535,0:mss,nop,ws,sok,eol+1:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","ws","sok","eol+1"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:65535,0:mss,nop,ws,nop,nop,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","ws","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:65535,0:mss,nop,nop,ts,sok,eol+1:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","nop","ts","sok","eol+1"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:65535,0:mss,nop,ws,nop,nop,ts,sok,eol+1:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 65535, Scale: 0, Layout: []string{"mss","nop","ws","nop","nop","ts","sok","eol+1"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:unix:Solaris:6", Class: "unix", Name: "Solaris", Flavor: "6", Sys: nil, Sig: []string{"4:255:0:*:mss*7,0:mss:df,id+:0","4:255:0:*:mss*7,0:nop,ws,mss:df,id+:0","4:255:0:*:mss*7,0:nop,nop,ts,mss:df,id+:0","4:255:0:*:mss*7,0:nop,nop,ts,nop,ws,mss:df,id+:0"},
+TCP: []Signature{
+{Raw: "4:255:0:*:mss*7,0:mss:df,id+:0", Version: 4, TTL: 255, OLen: 0, MSS: Any, WinType: WinMSS, Win: 7, Scale: 0, Layout: []string{"mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "4:255:0:*:mss*7,0:nop,ws,mss:df,id+:0", Version: 4, TTL: 255, OLen: 0, MSS: Any, WinType: WinMSS, Win: 7, Scale: 0, Layout: []string{"nop","ws","mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "4:255:0:*:mss*7,0:nop,nop,ts,mss:df,id+:0", Version: 4, TTL: 255, OLen: 0, MSS: Any, WinType: WinMSS, Win: 7, Scale: 0, Layout: []string{"nop","nop","ts","mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "4:255:0:*:mss*7,0:nop,nop,ts,nop,ws,mss:df,id+:0", Version: 4, TTL: 255, OLen: 0, MSS: Any, WinType: WinMSS, Win: 7, Scale: 0, Layout: []string{"nop","nop","ts","nop","ws","mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:unix:Solaris:8", Class: "unix", Name: "Solaris", Flavor: "8", Sys: nil, Sig: []string{"*:64:0:*:mss*19,0:mss:df,id+:0","*:64:0:*:mss*19,0:nop,ws,mss:df,id+:0","*:64:0:*:mss*19,0:nop,nop,ts,mss:df,id+:0","*:64:0:*:mss*19,0:nop,nop,sok,mss:df,id+:0","*:64:0:*:mss*19,0:nop,nop,ts,nop,ws,mss:df,id+:0","*:64:0:*:mss*19,0:nop,ws,nop,nop,sok,mss:df,id+:0","*:64:0:*:mss*19,0:nop,nop,ts,nop,nop,sok,mss:df,id+:0","*:64:0:*:mss*19,0:nop,nop,ts,nop,ws,nop,nop,sok,mss:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*19,0:mss:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 19, Scale: 0, Layout: []string{"mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*19,0:nop,ws,mss:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 19, Scale: 0, Layout: []string{"nop","ws","mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*19,0:nop,nop,ts,mss:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 19, Scale: 0, Layout: []string{"nop","nop","ts","mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*19,0:nop,nop,sok,mss:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 19, Scale: 0, Layout: []string{"nop","nop","sok","mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*19,0:nop,nop,ts,nop,ws,mss:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 19, Scale: 0, Layout: []string{"nop","nop","ts","nop","ws","mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*19,0:nop,ws,nop,nop,sok,mss:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 19, Scale: 0, Layout: []string{"nop","ws","nop","nop","sok","mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*19,0:nop,nop,ts,nop,nop,sok,mss:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 19, Scale: 0, Layout: []string{"nop","nop","ts","nop","nop","sok","mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*19,0:nop,nop,ts,nop,ws,nop,nop,sok,mss:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 19, Scale: 0, Layout: []string{"nop","nop","ts","nop","ws","nop","nop","sok","mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:unix:Solaris:10", Class: "unix", Name: "Solaris", Flavor: "10", Sys: nil, Sig: []string{"*:64:0:*:mss*37,0:mss:df,id+:0","*:64:0:*:mss*37,0:mss,nop,ws:df,id+:0","*:64:0:*:mss*37,0:nop,nop,ts,mss:df,id+:0","*:64:0:*:mss*37,0:mss,nop,nop,sok:df,id+:0","*:64:0:*:mss*37,0:nop,nop,ts,mss,nop,ws:df,id+:0","*:64:0:*:mss*37,0:mss,nop,ws,nop,nop,sok:df,id+:0","*:64:0:*:mss*37,0:nop,nop,ts,mss,nop,nop,sok:df,id+:0","*:64:0:*:mss*37,0:nop,nop,ts,mss,nop,ws,nop,nop,sok:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:mss*37,0:mss:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 37, Scale: 0, Layout: []string{"mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*37,0:mss,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 37, Scale: 0, Layout: []string{"mss","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*37,0:nop,nop,ts,mss:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 37, Scale: 0, Layout: []string{"nop","nop","ts","mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*37,0:mss,nop,nop,sok:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 37, Scale: 0, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*37,0:nop,nop,ts,mss,nop,ws:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 37, Scale: 0, Layout: []string{"nop","nop","ts","mss","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*37,0:mss,nop,ws,nop,nop,sok:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 37, Scale: 0, Layout: []string{"mss","nop","ws","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*37,0:nop,nop,ts,mss,nop,nop,sok:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 37, Scale: 0, Layout: []string{"nop","nop","ts","mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:mss*37,0:nop,nop,ts,mss,nop,ws,nop,nop,sok:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinMSS, Win: 37, Scale: 0, Layout: []string{"nop","nop","ts","mss","nop","ws","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:unix:HP-UX:11.x", Class: "unix", Name: "HP-UX", Flavor: "11.x", Sys: nil, Sig: []string{"*:64:0:*:32768,0:mss:df,id+:0","*:64:0:*:32768,0:mss,ws,nop:df,id+:0","*:64:0:*:32768,0:mss,nop,nop,ts:df,id+:0","*:64:0:*:32768,0:mss,nop,nop,sok:df,id+:0","*:64:0:*:32768,0:mss,ws,nop,nop,nop,ts:df,id+:0","*:64:0:*:32768,0:mss,nop,nop,sok,ws,nop:df,id+:0","*:64:0:*:32768,0:mss,nop,nop,sok,nop,nop,ts:df,id+:0","*:64:0:*:32768,0:mss,nop,nop,sok,ws,nop,nop,nop,ts:df,id+:0"},
+TCP: []Signature{
+{Raw: "*:64:0:*:32768,0:mss:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 32768, Scale: 0, Layout: []string{"mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:32768,0:mss,ws,nop:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 32768, Scale: 0, Layout: []string{"mss","ws","nop"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:32768,0:mss,nop,nop,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 32768, Scale: 0, Layout: []string{"mss","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:32768,0:mss,nop,nop,sok:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 32768, Scale: 0, Layout: []string{"mss","nop","nop","sok"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:32768,0:mss,ws,nop,nop,nop,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 32768, Scale: 0, Layout: []string{"mss","ws","nop","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:32768,0:mss,nop,nop,sok,ws,nop:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 32768, Scale: 0, Layout: []string{"mss","nop","nop","sok","ws","nop"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:32768,0:mss,nop,nop,sok,nop,nop,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 32768, Scale: 0, Layout: []string{"mss","nop","nop","sok","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "*:64:0:*:32768,0:mss,nop,nop,sok,ws,nop,nop,nop,ts:df,id+:0", Version: Any, TTL: 64, OLen: 0, MSS: Any, WinType: WinValue, Win: 32768, Scale: 0, Layout: []string{"mss","nop","nop","sok","ws","nop","nop","nop","ts"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:other:OpenVMS:7.x", Class: "other", Name: "OpenVMS", Flavor: "7.x", Sys: nil, Sig: []string{"4:64:0:1460:3993,0:mss::0","4:64:0:1460:3993,0:mss,nop,ws::0"},
+TCP: []Signature{
+{Raw: "4:64:0:1460:3993,0:mss::0", Version: 4, TTL: 64, OLen: 0, MSS: 1460, WinType: WinValue, Win: 3993, Scale: 0, Layout: []string{"mss"}, Quirks: 0, PClass: 0},
+{Raw: "4:64:0:1460:3993,0:mss,nop,ws::0", Version: 4, TTL: 64, OLen: 0, MSS: 1460, WinType: WinValue, Win: 3993, Scale: 0, Layout: []string{"mss","nop","ws"}, Quirks: 0, PClass: 0},
+}},
+{Section: "tcp:response", Label: "s:unix:Tru64:4.x", Class: "unix", Name: "Tru64", Flavor: "4.x", Sys: nil, Sig: []string{"4:64:0:1460:mss*25,0:mss,nop,ws:df,id+:0","4:64:0:1460:mss*25,0:mss:df,id+:0"},
+TCP: []Signature{
+{Raw: "4:64:0:1460:mss*25,0:mss,nop,ws:df,id+:0", Version: 4, TTL: 64, OLen: 0, MSS: 1460, WinType: WinMSS, Win: 25, Scale: 0, Layout: []string{"mss","nop","ws"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+{Raw: "4:64:0:1460:mss*25,0:mss:df,id+:0", Version: 4, TTL: 64, OLen: 0, MSS: 1460, WinType: WinMSS, Win: 25, Scale: 0, Layout: []string{"mss"}, Quirks: QuirkDF|QuirkNonZeroID, PClass: 0},
+}},
+{Section: "http:request", Label: "s:!:Firefox:2.x", Class: "!", Name: "Firefox", Flavor: "2.x", Sys: []string{"Windows","Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip,deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],Keep-Alive=[300],Connection=[keep-alive]::Firefox/"},
+HTTP: []HTTPSignature{
+{Raw: "*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip,deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],Keep-Alive=[300],Connection=[keep-alive]::Firefox/", Version: Any, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: ",*/*;q=", HasValue: true},{Name: "Accept-Language", Optional: true},{Name: "Accept-Encoding", Value: "gzip,deflate", HasValue: true},{Name: "Accept-Charset", Value: "utf-8;q=0.7,*;q=0.7", HasValue: true},{Name: "Keep-Alive", Value: "300", HasValue: true},{Name: "Connection", Value: "keep-alive", HasValue: true}}, Absent: nil, Software: "Firefox/"},
+}},
+{Section: "http:request", Label: "s:!:Firefox:3.x", Class: "!", Name: "Firefox", Flavor: "3.x", Sys: []string{"Windows","Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip,deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],Keep-Alive=[115],Connection=[keep-alive],?Referer::Firefox/"},
+HTTP: []HTTPSignature{
+{Raw: "*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip,deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],Keep-Alive=[115],Connection=[keep-alive],?Referer::Firefox/", Version: Any, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: ",*/*;q=", HasValue: true},{Name: "Accept-Language", Optional: true},{Name: "Accept-Encoding", Value: "gzip,deflate", HasValue: true},{Name: "Accept-Charset", Value: "utf-8;q=0.7,*;q=0.7", HasValue: true},{Name: "Keep-Alive", Value: "115", HasValue: true},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Referer", Optional: true}}, Absent: nil, Software: "Firefox/"},
+}},
+{Section: "http:request", Label: "s:!:Firefox:4.x", Class: "!", Name: "Firefox", Flavor: "4.x", Sys: []string{"Windows","Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],Keep-Alive=[115],Connection=[keep-alive],?Referer::Firefox/"},
+HTTP: []HTTPSignature{
+{Raw: "*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],Keep-Alive=[115],Connection=[keep-alive],?Referer::Firefox/", Version: Any, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: ",*/*;q=", HasValue: true},{Name: "Accept-Language", Optional: true},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Accept-Charset", Value: "utf-8;q=0.7,*;q=0.7", HasValue: true},{Name: "Keep-Alive", Value: "115", HasValue: true},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Referer", Optional: true}}, Absent: nil, Software: "Firefox/"},
+}},
+{Section: "http:request", Label: "s:!:Firefox:5.x-9.x", Class: "!", Name: "Firefox", Flavor: "5.x-9.x", Sys: []string{"Windows","Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],?DNT=[1],Connection=[keep-alive],?Referer:Keep-Alive:Firefox/","*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[UTF-8,*],?DNT=[1],Connection=[keep-alive],?Referer:Keep-Alive:Firefox/","*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[UTF-8,*],?DNT=[1],?Referer,Connection=[keep-alive]:Keep-Alive:Firefox/","*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],?DNT=[1],?Referer,Connection=[keep-alive]:Keep-Alive:Firefox/","*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],?Referer,?DNT=[1],Connection=[keep-alive]:Keep-Alive:Firefox/"},
+HTTP: []HTTPSignature{
+{Raw: "*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],?DNT=[1],Connection=[keep-alive],?Referer:Keep-Alive:Firefox/", Version: Any, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: ",*/*;q=", HasValue: true},{Name: "Accept-Language", Optional: true},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Accept-Charset", Value: "utf-8;q=0.7,*;q=0.7", HasValue: true},{Name: "DNT", Value: "1", HasValue: true, Optional: true},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Referer", Optional: true}}, Absent: []string{"Keep-Alive"}, Software: "Firefox/"},
+{Raw: "*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[UTF-8,*],?DNT=[1],Connection=[keep-alive],?Referer:Keep-Alive:Firefox/", Version: Any, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: ",*/*;q=", HasValue: true},{Name: "Accept-Language", Optional: true},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Accept-Charset", Value: "UTF-8,*", HasValue: true},{Name: "DNT", Value: "1", HasValue: true, Optional: true},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Referer", Optional: true}}, Absent: []string{"Keep-Alive"}, Software: "Firefox/"},
+{Raw: "*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[UTF-8,*],?DNT=[1],?Referer,Connection=[keep-alive]:Keep-Alive:Firefox/", Version: Any, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: ",*/*;q=", HasValue: true},{Name: "Accept-Language", Optional: true},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Accept-Charset", Value: "UTF-8,*", HasValue: true},{Name: "DNT", Value: "1", HasValue: true, Optional: true},{Name: "Referer", Optional: true},{Name: "Connection", Value: "keep-alive", HasValue: true}}, Absent: []string{"Keep-Alive"}, Software: "Firefox/"},
+{Raw: "*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],?DNT=[1],?Referer,Connection=[keep-alive]:Keep-Alive:Firefox/", Version: Any, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: ",*/*;q=", HasValue: true},{Name: "Accept-Language", Optional: true},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Accept-Charset", Value: "utf-8;q=0.7,*;q=0.7", HasValue: true},{Name: "DNT", Value: "1", HasValue: true, Optional: true},{Name: "Referer", Optional: true},{Name: "Connection", Value: "keep-alive", HasValue: true}}, Absent: []string{"Keep-Alive"}, Software: "Firefox/"},
+{Raw: "*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language,Accept-Encoding=[gzip, deflate],Accept-Charset=[utf-8;q=0.7,*;q=0.7],?Referer,?DNT=[1],Connection=[keep-alive]:Keep-Alive:Firefox/", Version: Any, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: ",*/*;q=", HasValue: true},{Name: "Accept-Language", Optional: true},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Accept-Charset", Value: "utf-8;q=0.7,*;q=0.7", HasValue: true},{Name: "Referer", Optional: true},{Name: "DNT", Value: "1", HasValue: true, Optional: true},{Name: "Connection", Value: "keep-alive", HasValue: true}}, Absent: []string{"Keep-Alive"}, Software: "Firefox/"},
+}},
+{Section: "http:request", Label: "s:!:Firefox:10.x or newer", Class: "!", Name: "Firefox", Flavor: "10.x or newer", Sys: []string{"Windows","Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language=[;q=],Accept-Encoding=[gzip, deflate],?DNT=[1],Connection=[keep-alive],?Referer:Accept-Charset,Keep-Alive:Firefox/","*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language=[;q=],Accept-Encoding=[gzip, deflate],?DNT=[1],?Referer,Connection=[keep-alive]:Accept-Charset,Keep-Alive:Firefox/"},
+HTTP: []HTTPSignature{
+{Raw: "*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language=[;q=],Accept-Encoding=[gzip, deflate],?DNT=[1],Connection=[keep-alive],?Referer:Accept-Charset,Keep-Alive:Firefox/", Version: Any, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: ",*/*;q=", HasValue: true},{Name: "Accept-Language", Value: ";q=", HasValue: true, Optional: true},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "DNT", Value: "1", HasValue: true, Optional: true},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Referer", Optional: true}}, Absent: []string{"Accept-Charset","Keep-Alive"}, Software: "Firefox/"},
+{Raw: "*:Host,User-Agent,Accept=[,*/*;q=],?Accept-Language=[;q=],Accept-Encoding=[gzip, deflate],?DNT=[1],?Referer,Connection=[keep-alive]:Accept-Charset,Keep-Alive:Firefox/", Version: Any, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: ",*/*;q=", HasValue: true},{Name: "Accept-Language", Value: ";q=", HasValue: true, Optional: true},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "DNT", Value: "1", HasValue: true, Optional: true},{Name: "Referer", Optional: true},{Name: "Connection", Value: "keep-alive", HasValue: true}}, Absent: []string{"Accept-Charset","Keep-Alive"}, Software: "Firefox/"},
+}},
+{Section: "http:request", Label: "s:!:Firefox:10.x or Safari 5.x", Class: "!", Name: "Firefox", Flavor: "10.x or Safari 5.x", Sys: []string{"Windows","Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"*:Host,User-Agent,Accept=[xml;q=0.9,*/*;q=0.8],Accept-Language,Accept-Encoding=[gzip, deflate],Connection=[keep-alive]:Keep-Alive,Accept-Charset,DNT,Referer:Gecko"},
+HTTP: []HTTPSignature{
+{Raw: "*:Host,User-Agent,Accept=[xml;q=0.9,*/*;q=0.8],Accept-Language,Accept-Encoding=[gzip, deflate],Connection=[keep-alive]:Keep-Alive,Accept-Charset,DNT,Referer:Gecko", Version: Any, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: "xml;q=0.9,*/*;q=0.8", HasValue: true},{Name: "Accept-Language"},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Connection", Value: "keep-alive", HasValue: true}}, Absent: []string{"Keep-Alive","Accept-Charset","DNT","Referer"}, Software: "Gecko"},
+}},
+{Section: "http:request", Label: "s:!:MSIE:8 or newer", Class: "!", Name: "MSIE", Flavor: "8 or newer", Sys: []string{"Windows"}, Sig: []string{"1:Accept=[*/*],?Referer,?Accept-Language,User-Agent,Accept-Encoding=[gzip, deflate],Host,Connection=[Keep-Alive]:Keep-Alive,Accept-Charset,UA-CPU:Trident/","1:Accept=[*/*],?Referer,?Accept-Language,Accept-Encoding=[gzip, deflate],User-Agent,Host,Connection=[Keep-Alive]:Keep-Alive,Accept-Charset:(compatible; MSIE"},
+HTTP: []HTTPSignature{
+{Raw: "1:Accept=[*/*],?Referer,?Accept-Language,User-Agent,Accept-Encoding=[gzip, deflate],Host,Connection=[Keep-Alive]:Keep-Alive,Accept-Charset,UA-CPU:Trident/", Version: 1, Order: []HTTPSigHeader{{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Referer", Optional: true},{Name: "Accept-Language", Optional: true},{Name: "User-Agent"},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Host"},{Name: "Connection", Value: "Keep-Alive", HasValue: true}}, Absent: []string{"Keep-Alive","Accept-Charset","UA-CPU"}, Software: "Trident/"},
+{Raw: "1:Accept=[*/*],?Referer,?Accept-Language,Accept-Encoding=[gzip, deflate],User-Agent,Host,Connection=[Keep-Alive]:Keep-Alive,Accept-Charset:(compatible; MSIE", Version: 1, Order: []HTTPSigHeader{{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Referer", Optional: true},{Name: "Accept-Language", Optional: true},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "User-Agent"},{Name: "Host"},{Name: "Connection", Value: "Keep-Alive", HasValue: true}}, Absent: []string{"Keep-Alive","Accept-Charset"}, Software: "(compatible; MSIE"},
+}},
+{Section: "http:request", Label: "s:!:MSIE:7", Class: "!", Name: "MSIE", Flavor: "7", Sys: []string{"Windows"}, Sig: []string{"1:Accept=[*/*],?Referer,?Accept-Language,UA-CPU,User-Agent,Accept-Encoding=[gzip, deflate],Host,Connection=[Keep-Alive]:Keep-Alive,Accept-Charset:(compatible; MSIE"},
+HTTP: []HTTPSignature{
+{Raw: "1:Accept=[*/*],?Referer,?Accept-Language,UA-CPU,User-Agent,Accept-Encoding=[gzip, deflate],Host,Connection=[Keep-Alive]:Keep-Alive,Accept-Charset:(compatible; MSIE", Version: 1, Order: []HTTPSigHeader{{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Referer", Optional: true},{Name: "Accept-Language", Optional: true},{Name: "UA-CPU"},{Name: "User-Agent"},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Host"},{Name: "Connection", Value: "Keep-Alive", HasValue: true}}, Absent: []string{"Keep-Alive","Accept-Charset"}, Software: "(compatible; MSIE"},
+}},
+{Section: "http:request", Label: "s:!:MSIE:6", Class: "!", Name: "MSIE", Flavor: "6", Sys: []string{"Windows"}, Sig: []string{"0:Accept=[*/*],?Referer,User-Agent,Host:Keep-Alive,Connection,Accept-Encoding,Accept-Language,Accept-Charset:(compatible; MSIE","1:Accept=[*/*],Connection=[Keep-Alive],Host,?Pragma=[no-cache],?Range,?Referer,User-Agent:Keep-Alive,Accept-Encoding,Accept-Language,Accept-Charset:(compatible; MSIE"},
+HTTP: []HTTPSignature{
+{Raw: "0:Accept=[*/*],?Referer,User-Agent,Host:Keep-Alive,Connection,Accept-Encoding,Accept-Language,Accept-Charset:(compatible; MSIE", Version: 0, Order: []HTTPSigHeader{{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Referer", Optional: true},{Name: "User-Agent"},{Name: "Host"}}, Absent: []string{"Keep-Alive","Connection","Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "(compatible; MSIE"},
+{Raw: "1:Accept=[*/*],Connection=[Keep-Alive],Host,?Pragma=[no-cache],?Range,?Referer,User-Agent:Keep-Alive,Accept-Encoding,Accept-Language,Accept-Charset:(compatible; MSIE", Version: 1, Order: []HTTPSigHeader{{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Connection", Value: "Keep-Alive", HasValue: true},{Name: "Host"},{Name: "Pragma", Value: "no-cache", HasValue: true, Optional: true},{Name: "Range", Optional: true},{Name: "Referer", Optional: true},{Name: "User-Agent"}}, Absent: []string{"Keep-Alive","Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "(compatible; MSIE"},
+}},
+{Section: "http:request", Label: "s:!:Chrome:11.x to 26.x", Class: "!", Name: "Chrome", Flavor: "11.x to 26.x", Sys: []string{"Windows","Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:Host,Connection=[keep-alive],User-Agent,Accept=[*/*],?Referer,Accept-Encoding=[gzip,deflate,sdch],Accept-Language,Accept-Charset=[utf-8;q=0.7,*;q=0.3]:: Chrom","1:Host,Connection=[keep-alive],User-Agent,Accept=[*/*],?Referer,Accept-Encoding=[gzip,deflate,sdch],Accept-Language,Accept-Charset=[UTF-8,*;q=0.5]:: Chrom","1:Host,User-Agent,Accept=[*/*],?Referer,Accept-Encoding=[gzip,deflate,sdch],Accept-Language,Accept-Charset=[utf-8;q=0.7,*;q=0.3],Connection=[keep-alive]::Chrom"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,Connection=[keep-alive],User-Agent,Accept=[*/*],?Referer,Accept-Encoding=[gzip,deflate,sdch],Accept-Language,Accept-Charset=[utf-8;q=0.7,*;q=0.3]:: Chrom", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "User-Agent"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Referer", Optional: true},{Name: "Accept-Encoding", Value: "gzip,deflate,sdch", HasValue: true},{Name: "Accept-Language"},{Name: "Accept-Charset", Value: "utf-8;q=0.7,*;q=0.3", HasValue: true}}, Absent: nil, Software: " Chrom"},
+{Raw: "1:Host,Connection=[keep-alive],User-Agent,Accept=[*/*],?Referer,Accept-Encoding=[gzip,deflate,sdch],Accept-Language,Accept-Charset=[UTF-8,*;q=0.5]:: Chrom", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "User-Agent"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Referer", Optional: true},{Name: "Accept-Encoding", Value: "gzip,deflate,sdch", HasValue: true},{Name: "Accept-Language"},{Name: "Accept-Charset", Value: "UTF-8,*;q=0.5", HasValue: true}}, Absent: nil, Software: " Chrom"},
+{Raw: "1:Host,User-Agent,Accept=[*/*],?Referer,Accept-Encoding=[gzip,deflate,sdch],Accept-Language,Accept-Charset=[utf-8;q=0.7,*;q=0.3],Connection=[keep-alive]::Chrom", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Referer", Optional: true},{Name: "Accept-Encoding", Value: "gzip,deflate,sdch", HasValue: true},{Name: "Accept-Language"},{Name: "Accept-Charset", Value: "utf-8;q=0.7,*;q=0.3", HasValue: true},{Name: "Connection", Value: "keep-alive", HasValue: true}}, Absent: nil, Software: "Chrom"},
+}},
+{Section: "http:request", Label: "s:!:Chrome:27.x to 42.x", Class: "!", Name: "Chrome", Flavor: "27.x to 42.x", Sys: []string{"Windows","Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:Host,Connection=[keep-alive],Accept=[*/*],User-Agent,?Referer,Accept-Encoding=[gzip,deflate,sdch],Accept-Language:Accept-Charset,Keep-Alive: Chrom"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,Connection=[keep-alive],Accept=[*/*],User-Agent,?Referer,Accept-Encoding=[gzip,deflate,sdch],Accept-Language:Accept-Charset,Keep-Alive: Chrom", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "User-Agent"},{Name: "Referer", Optional: true},{Name: "Accept-Encoding", Value: "gzip,deflate,sdch", HasValue: true},{Name: "Accept-Language"}}, Absent: []string{"Accept-Charset","Keep-Alive"}, Software: " Chrom"},
+}},
+{Section: "http:request", Label: "s:!:Chrome:43.x or 50.x", Class: "!", Name: "Chrome", Flavor: "43.x or 50.x", Sys: []string{"Windows","Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:Host,Connection=[keep-alive],Accept=[*/*],User-Agent,?Referer,Accept-Encoding=[gzip, deflate, sdch],Accept-Language:Accept-Charset,Keep-Alive: Chrom"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,Connection=[keep-alive],Accept=[*/*],User-Agent,?Referer,Accept-Encoding=[gzip, deflate, sdch],Accept-Language:Accept-Charset,Keep-Alive: Chrom", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "User-Agent"},{Name: "Referer", Optional: true},{Name: "Accept-Encoding", Value: "gzip, deflate, sdch", HasValue: true},{Name: "Accept-Language"}}, Absent: []string{"Accept-Charset","Keep-Alive"}, Software: " Chrom"},
+}},
+{Section: "http:request", Label: "s:!:Chrome:51.x or newer", Class: "!", Name: "Chrome", Flavor: "51.x or newer", Sys: []string{"Windows","Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:Host,Connection=[keep-alive],Upgrade-Insecure-Requests=[1],User-Agent,Accept=[*/*],Accept-Encoding=[gzip, deflate, sdch],Accept-Language:Accept-Charset,Keep-Alive: Chrom"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,Connection=[keep-alive],Upgrade-Insecure-Requests=[1],User-Agent,Accept=[*/*],Accept-Encoding=[gzip, deflate, sdch],Accept-Language:Accept-Charset,Keep-Alive: Chrom", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Upgrade-Insecure-Requests", Value: "1", HasValue: true},{Name: "User-Agent"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Accept-Encoding", Value: "gzip, deflate, sdch", HasValue: true},{Name: "Accept-Language"}}, Absent: []string{"Accept-Charset","Keep-Alive"}, Software: " Chrom"},
+}},
+{Section: "http:request", Label: "s:!:Opera:19.x or newer", Class: "!", Name: "Opera", Flavor: "19.x or newer", Sys: []string{"Windows","Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:Host,Connection=[keep-alive],Accept=[*/*;q=0.8],User-Agent,Accept-Encoding=[gzip,deflate,lzma,sdch],Accept-Language=[;q=0.]:Accept-Charset,Keep-Alive:OPR/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,Connection=[keep-alive],Accept=[*/*;q=0.8],User-Agent,Accept-Encoding=[gzip,deflate,lzma,sdch],Accept-Language=[;q=0.]:Accept-Charset,Keep-Alive:OPR/", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Accept", Value: "*/*;q=0.8", HasValue: true},{Name: "User-Agent"},{Name: "Accept-Encoding", Value: "gzip,deflate,lzma,sdch", HasValue: true},{Name: "Accept-Language", Value: ";q=0.", HasValue: true}}, Absent: []string{"Accept-Charset","Keep-Alive"}, Software: "OPR/"},
+}},
+{Section: "http:request", Label: "s:!:Opera:15.x-18.x", Class: "!", Name: "Opera", Flavor: "15.x-18.x", Sys: []string{"Windows","Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:Host,Connection=[keep-alive],Accept=[*/*;q=0.8],User-Agent,Accept-Encoding=[gzip, deflate],Accept-Language=[;q=0.]:Accept-Charset,Keep-Alive:OPR/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,Connection=[keep-alive],Accept=[*/*;q=0.8],User-Agent,Accept-Encoding=[gzip, deflate],Accept-Language=[;q=0.]:Accept-Charset,Keep-Alive:OPR/", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Accept", Value: "*/*;q=0.8", HasValue: true},{Name: "User-Agent"},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Accept-Language", Value: ";q=0.", HasValue: true}}, Absent: []string{"Accept-Charset","Keep-Alive"}, Software: "OPR/"},
+}},
+{Section: "http:request", Label: "s:!:Opera:11.x-14.x", Class: "!", Name: "Opera", Flavor: "11.x-14.x", Sys: []string{"Windows","Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:User-Agent,Host,Accept=[*/*;q=0.1],?Accept-Language=[;q=0.],Accept-Encoding=[gzip, deflate],Connection=[Keep-Alive]:Accept-Charset,X-OperaMini-Phone-UA:) Presto/"},
+HTTP: []HTTPSignature{
+{Raw: "1:User-Agent,Host,Accept=[*/*;q=0.1],?Accept-Language=[;q=0.],Accept-Encoding=[gzip, deflate],Connection=[Keep-Alive]:Accept-Charset,X-OperaMini-Phone-UA:) Presto/", Version: 1, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "Host"},{Name: "Accept", Value: "*/*;q=0.1", HasValue: true},{Name: "Accept-Language", Value: ";q=0.", HasValue: true, Optional: true},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Connection", Value: "Keep-Alive", HasValue: true}}, Absent: []string{"Accept-Charset","X-OperaMini-Phone-UA"}, Software: ") Presto/"},
+}},
+{Section: "http:request", Label: "s:!:Opera:10.x", Class: "!", Name: "Opera", Flavor: "10.x", Sys: []string{"Windows","Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:User-Agent,Host,Accept=[*/*;q=0.1],Accept-Language=[;q=0.],Accept-Charset=[utf-8, utf-16, *;q=0.1],Accept-Encoding=[deflate, gzip, x-gzip, identity, *;q=0],Connection=[Keep-Alive]::Presto/","1:User-Agent,Host,Accept=[*/*;q=0.1],Accept-Language=[en],Accept-Encoding=[gzip, deflate],Connection=[Keep-Alive]:Accept-Charset:Opera/"},
+HTTP: []HTTPSignature{
+{Raw: "1:User-Agent,Host,Accept=[*/*;q=0.1],Accept-Language=[;q=0.],Accept-Charset=[utf-8, utf-16, *;q=0.1],Accept-Encoding=[deflate, gzip, x-gzip, identity, *;q=0],Connection=[Keep-Alive]::Presto/", Version: 1, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "Host"},{Name: "Accept", Value: "*/*;q=0.1", HasValue: true},{Name: "Accept-Language", Value: ";q=0.", HasValue: true},{Name: "Accept-Charset", Value: "utf-8, utf-16, *;q=0.1", HasValue: true},{Name: "Accept-Encoding", Value: "deflate, gzip, x-gzip, identity, *;q=0", HasValue: true},{Name: "Connection", Value: "Keep-Alive", HasValue: true}}, Absent: nil, Software: "Presto/"},
+{Raw: "1:User-Agent,Host,Accept=[*/*;q=0.1],Accept-Language=[en],Accept-Encoding=[gzip, deflate],Connection=[Keep-Alive]:Accept-Charset:Opera/", Version: 1, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "Host"},{Name: "Accept", Value: "*/*;q=0.1", HasValue: true},{Name: "Accept-Language", Value: "en", HasValue: true},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Connection", Value: "Keep-Alive", HasValue: true}}, Absent: []string{"Accept-Charset"}, Software: "Opera/"},
+}},
+{Section: "http:request", Label: "s:!:Opera:Mini", Class: "!", Name: "Opera", Flavor: "Mini", Sys: []string{"Linux"}, Sig: []string{"1:User-Agent,Host,Accept=[*/*;q=0.1],Accept-Language=[;q=0.],Accept-Encoding=[gzip, deflate],Connection=[Keep-Alive],X-OperaMini-Phone-UA,X-OperaMini-Features,X-OperaMini-Phone,x-forwarded-for:Accept-Charset:Opera Mini/"},
+HTTP: []HTTPSignature{
+{Raw: "1:User-Agent,Host,Accept=[*/*;q=0.1],Accept-Language=[;q=0.],Accept-Encoding=[gzip, deflate],Connection=[Keep-Alive],X-OperaMini-Phone-UA,X-OperaMini-Features,X-OperaMini-Phone,x-forwarded-for:Accept-Charset:Opera Mini/", Version: 1, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "Host"},{Name: "Accept", Value: "*/*;q=0.1", HasValue: true},{Name: "Accept-Language", Value: ";q=0.", HasValue: true},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Connection", Value: "Keep-Alive", HasValue: true},{Name: "X-OperaMini-Phone-UA"},{Name: "X-OperaMini-Features"},{Name: "X-OperaMini-Phone"},{Name: "x-forwarded-for"}}, Absent: []string{"Accept-Charset"}, Software: "Opera Mini/"},
+}},
+{Section: "http:request", Label: "s:!:Opera:on Nintendo Wii", Class: "!", Name: "Opera", Flavor: "on Nintendo Wii", Sys: []string{"Nintendo"}, Sig: []string{"1:User-Agent,Host,Accept=[*/*;q=0.1],Accept-Language=[en],Accept-Charset=[iso-8859-1, utf-8, utf-16, *;q=0.1],Accept-Encoding=[deflate, gzip, x-gzip, identity, *;q=0],Connection=[Keep-Alive]::Nintendo"},
+HTTP: []HTTPSignature{
+{Raw: "1:User-Agent,Host,Accept=[*/*;q=0.1],Accept-Language=[en],Accept-Charset=[iso-8859-1, utf-8, utf-16, *;q=0.1],Accept-Encoding=[deflate, gzip, x-gzip, identity, *;q=0],Connection=[Keep-Alive]::Nintendo", Version: 1, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "Host"},{Name: "Accept", Value: "*/*;q=0.1", HasValue: true},{Name: "Accept-Language", Value: "en", HasValue: true},{Name: "Accept-Charset", Value: "iso-8859-1, utf-8, utf-16, *;q=0.1", HasValue: true},{Name: "Accept-Encoding", Value: "deflate, gzip, x-gzip, identity, *;q=0", HasValue: true},{Name: "Connection", Value: "Keep-Alive", HasValue: true}}, Absent: nil, Software: "Nintendo"},
+}},
+{Section: "http:request", Label: "s:!:Android:2.x", Class: "!", Name: "Android", Flavor: "2.x", Sys: []string{"Linux"}, Sig: []string{"1:Host,Accept-Encoding=[gzip],Accept-Language,User-Agent,Accept=[,*/*;q=0.5],Accept-Charset=[utf-16, *;q=0.7]:Connection:Android","1:Host,Connection=[keep-alive],Accept-Encoding=[gzip],Accept-Language,User-Agent,Accept=[,*/*;q=0.5],Accept-Charset=[utf-16, *;q=0.7]::Android","1:Host,Accept-Encoding=[gzip],Accept-Language=[en-US],Accept=[*/*;q=0.5],User-Agent,Accept-Charset=[utf-16, *;q=0.7]:Connection:Android"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,Accept-Encoding=[gzip],Accept-Language,User-Agent,Accept=[,*/*;q=0.5],Accept-Charset=[utf-16, *;q=0.7]:Connection:Android", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Accept-Encoding", Value: "gzip", HasValue: true},{Name: "Accept-Language"},{Name: "User-Agent"},{Name: "Accept", Value: ",*/*;q=0.5", HasValue: true},{Name: "Accept-Charset", Value: "utf-16, *;q=0.7", HasValue: true}}, Absent: []string{"Connection"}, Software: "Android"},
+{Raw: "1:Host,Connection=[keep-alive],Accept-Encoding=[gzip],Accept-Language,User-Agent,Accept=[,*/*;q=0.5],Accept-Charset=[utf-16, *;q=0.7]::Android", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Accept-Encoding", Value: "gzip", HasValue: true},{Name: "Accept-Language"},{Name: "User-Agent"},{Name: "Accept", Value: ",*/*;q=0.5", HasValue: true},{Name: "Accept-Charset", Value: "utf-16, *;q=0.7", HasValue: true}}, Absent: nil, Software: "Android"},
+{Raw: "1:Host,Accept-Encoding=[gzip],Accept-Language=[en-US],Accept=[*/*;q=0.5],User-Agent,Accept-Charset=[utf-16, *;q=0.7]:Connection:Android", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Accept-Encoding", Value: "gzip", HasValue: true},{Name: "Accept-Language", Value: "en-US", HasValue: true},{Name: "Accept", Value: "*/*;q=0.5", HasValue: true},{Name: "User-Agent"},{Name: "Accept-Charset", Value: "utf-16, *;q=0.7", HasValue: true}}, Absent: []string{"Connection"}, Software: "Android"},
+}},
+{Section: "http:request", Label: "s:!:Android:4.x", Class: "!", Name: "Android", Flavor: "4.x", Sys: []string{"Linux"}, Sig: []string{"1:Host,Connection=[keep-alive],Accept=[,*/*;q=0.8],User-Agent,Accept-Encoding=[gzip,deflate],Accept-Language,Accept-Charset=[utf-16, *;q=0.7]::Android"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,Connection=[keep-alive],Accept=[,*/*;q=0.8],User-Agent,Accept-Encoding=[gzip,deflate],Accept-Language,Accept-Charset=[utf-16, *;q=0.7]::Android", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Accept", Value: ",*/*;q=0.8", HasValue: true},{Name: "User-Agent"},{Name: "Accept-Encoding", Value: "gzip,deflate", HasValue: true},{Name: "Accept-Language"},{Name: "Accept-Charset", Value: "utf-16, *;q=0.7", HasValue: true}}, Absent: nil, Software: "Android"},
+}},
+{Section: "http:request", Label: "s:!:Safari:7 or newer", Class: "!", Name: "Safari", Flavor: "7 or newer", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"*:Host,Accept-Encoding=[gzip, deflate],Connection=[keep-alive],Accept=[*/*],User-Agent,Accept-Language,?Referer,?DNT:Accept-Charset,Keep-Alive:KHTML, like Gecko)"},
+HTTP: []HTTPSignature{
+{Raw: "*:Host,Accept-Encoding=[gzip, deflate],Connection=[keep-alive],Accept=[*/*],User-Agent,Accept-Language,?Referer,?DNT:Accept-Charset,Keep-Alive:KHTML, like Gecko)", Version: Any, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "User-Agent"},{Name: "Accept-Language"},{Name: "Referer", Optional: true},{Name: "DNT", Optional: true}}, Absent: []string{"Accept-Charset","Keep-Alive"}, Software: "KHTML, like Gecko)"},
+}},
+{Section: "http:request", Label: "s:!:Safari:5.1-6", Class: "!", Name: "Safari", Flavor: "5.1-6", Sys: []string{"Windows","Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"*:Host,User-Agent,Accept=[*/*],?Referer,Accept-Language,Accept-Encoding=[gzip, deflate],Connection=[keep-alive]:Accept-Charset:KHTML, like Gecko)","*:Host,User-Agent,Accept=[*/*],?Referer,Accept-Encoding=[gzip, deflate],Accept-Language,Connection=[keep-alive]:Accept-Charset:KHTML, like Gecko)"},
+HTTP: []HTTPSignature{
+{Raw: "*:Host,User-Agent,Accept=[*/*],?Referer,Accept-Language,Accept-Encoding=[gzip, deflate],Connection=[keep-alive]:Accept-Charset:KHTML, like Gecko)", Version: Any, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Referer", Optional: true},{Name: "Accept-Language"},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Connection", Value: "keep-alive", HasValue: true}}, Absent: []string{"Accept-Charset"}, Software: "KHTML, like Gecko)"},
+{Raw: "*:Host,User-Agent,Accept=[*/*],?Referer,Accept-Encoding=[gzip, deflate],Accept-Language,Connection=[keep-alive]:Accept-Charset:KHTML, like Gecko)", Version: Any, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Referer", Optional: true},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "Accept-Language"},{Name: "Connection", Value: "keep-alive", HasValue: true}}, Absent: []string{"Accept-Charset"}, Software: "KHTML, like Gecko)"},
+}},
+{Section: "http:request", Label: "s:!:Safari:5.0 or earlier", Class: "!", Name: "Safari", Flavor: "5.0 or earlier", Sys: []string{"Mac OS X"}, Sig: []string{"0:Host,User-Agent,Connection=[close]:Accept,Accept-Encoding,Accept-Language,Accept-Charset:CFNetwork/"},
+HTTP: []HTTPSignature{
+{Raw: "0:Host,User-Agent,Connection=[close]:Accept,Accept-Encoding,Accept-Language,Accept-Charset:CFNetwork/", Version: 0, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Connection", Value: "close", HasValue: true}}, Absent: []string{"Accept","Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "CFNetwork/"},
+}},
+{Section: "http:request", Label: "s:!:Konqueror:4.6 or earlier", Class: "!", Name: "Konqueror", Flavor: "4.6 or earlier", Sys: []string{"Linux","FreeBSD","OpenBSD"}, Sig: []string{"1:Host,Connection=[Keep-Alive],User-Agent,?Pragma,?Cache-control,Accept=[*/*],Accept-Encoding=[x-gzip, x-deflate, gzip, deflate],Accept-Charset=[;q=0.5, *;q=0.5],Accept-Language::Konqueror/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,Connection=[Keep-Alive],User-Agent,?Pragma,?Cache-control,Accept=[*/*],Accept-Encoding=[x-gzip, x-deflate, gzip, deflate],Accept-Charset=[;q=0.5, *;q=0.5],Accept-Language::Konqueror/", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "Keep-Alive", HasValue: true},{Name: "User-Agent"},{Name: "Pragma", Optional: true},{Name: "Cache-control", Optional: true},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Accept-Encoding", Value: "x-gzip, x-deflate, gzip, deflate", HasValue: true},{Name: "Accept-Charset", Value: ";q=0.5, *;q=0.5", HasValue: true},{Name: "Accept-Language"}}, Absent: nil, Software: "Konqueror/"},
+}},
+{Section: "http:request", Label: "s:!:Konqueror:4.7 or newer", Class: "!", Name: "Konqueror", Flavor: "4.7 or newer", Sys: []string{"Linux","FreeBSD","OpenBSD"}, Sig: []string{"1:Host,Connection=[keep-alive],User-Agent,Accept=[*/*],Accept-Encoding=[gzip, deflate, x-gzip, x-deflate],Accept-Charset=[,*;q=0.5],Accept-Language::Konqueror/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,Connection=[keep-alive],User-Agent,Accept=[*/*],Accept-Encoding=[gzip, deflate, x-gzip, x-deflate],Accept-Charset=[,*;q=0.5],Accept-Language::Konqueror/", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "User-Agent"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Accept-Encoding", Value: "gzip, deflate, x-gzip, x-deflate", HasValue: true},{Name: "Accept-Charset", Value: ",*;q=0.5", HasValue: true},{Name: "Accept-Language"}}, Absent: nil, Software: "Konqueror/"},
+}},
+{Section: "http:request", Label: "s:!:BaiduSpider:", Class: "!", Name: "BaiduSpider", Flavor: "", Sys: []string{"BaiduSpider"}, Sig: []string{"1:Host,Connection=[close],User-Agent,Accept=[*/*]:Accept-Encoding,Accept-Language,Accept-Charset:Baiduspider-image","1:Host,Accept-Language=[zh-cn],Connection=[close],User-Agent:Accept,Accept-Encoding,Accept-Charset:Baiduspider","1:Host,Connection=[close],User-Agent,Accept-Language=[zh-cn,zh-tw],Accept-Encoding=[gzip],Accept=[*/*]:Accept-Charset:Baiduspider","1:Host,Connection=[close],User-Agent,Accept-Language=[tr-TR],Accept-Encoding=[gzip],Accept=[*/*]:Accept-Charset:Baiduspider","1:Host,Connection=[close],User-Agent,Accept-Encoding=[gzip],?Accept-Language=[zh-cn,zh-tw],Accept=[*/*]:Accept-Charset:Baiduspider","1:Host,Connection=[close],User-Agent,Accept-Encoding=[gzip],Accept-Language=[tr-TR],Accept=[*/*]:Accept-Charset:Baiduspider"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,Connection=[close],User-Agent,Accept=[*/*]:Accept-Encoding,Accept-Language,Accept-Charset:Baiduspider-image", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "close", HasValue: true},{Name: "User-Agent"},{Name: "Accept", Value: "*/*", HasValue: true}}, Absent: []string{"Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "Baiduspider-image"},
+{Raw: "1:Host,Accept-Language=[zh-cn],Connection=[close],User-Agent:Accept,Accept-Encoding,Accept-Charset:Baiduspider", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Accept-Language", Value: "zh-cn", HasValue: true},{Name: "Connection", Value: "close", HasValue: true},{Name: "User-Agent"}}, Absent: []string{"Accept","Accept-Encoding","Accept-Charset"}, Software: "Baiduspider"},
+{Raw: "1:Host,Connection=[close],User-Agent,Accept-Language=[zh-cn,zh-tw],Accept-Encoding=[gzip],Accept=[*/*]:Accept-Charset:Baiduspider", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "close", HasValue: true},{Name: "User-Agent"},{Name: "Accept-Language", Value: "zh-cn,zh-tw", HasValue: true},{Name: "Accept-Encoding", Value: "gzip", HasValue: true},{Name: "Accept", Value: "*/*", HasValue: true}}, Absent: []string{"Accept-Charset"}, Software: "Baiduspider"},
+{Raw: "1:Host,Connection=[close],User-Agent,Accept-Language=[tr-TR],Accept-Encoding=[gzip],Accept=[*/*]:Accept-Charset:Baiduspider", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "close", HasValue: true},{Name: "User-Agent"},{Name: "Accept-Language", Value: "tr-TR", HasValue: true},{Name: "Accept-Encoding", Value: "gzip", HasValue: true},{Name: "Accept", Value: "*/*", HasValue: true}}, Absent: []string{"Accept-Charset"}, Software: "Baiduspider"},
+{Raw: "1:Host,Connection=[close],User-Agent,Accept-Encoding=[gzip],?Accept-Language=[zh-cn,zh-tw],Accept=[*/*]:Accept-Charset:Baiduspider", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "close", HasValue: true},{Name: "User-Agent"},{Name: "Accept-Encoding", Value: "gzip", HasValue: true},{Name: "Accept-Language", Value: "zh-cn,zh-tw", HasValue: true, Optional: true},{Name: "Accept", Value: "*/*", HasValue: true}}, Absent: []string{"Accept-Charset"}, Software: "Baiduspider"},
+{Raw: "1:Host,Connection=[close],User-Agent,Accept-Encoding=[gzip],Accept-Language=[tr-TR],Accept=[*/*]:Accept-Charset:Baiduspider", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "close", HasValue: true},{Name: "User-Agent"},{Name: "Accept-Encoding", Value: "gzip", HasValue: true},{Name: "Accept-Language", Value: "tr-TR", HasValue: true},{Name: "Accept", Value: "*/*", HasValue: true}}, Absent: []string{"Accept-Charset"}, Software: "Baiduspider"},
+}},
+{Section: "http:request", Label: "s:!:Googlebot:", Class: "!", Name: "Googlebot", Flavor: "", Sys: []string{"Linux"}, Sig: []string{"1:Host,Connection=[Keep-alive],Accept=[*/*],From=[googlebot(at)googlebot.com],User-Agent,Accept-Encoding=[gzip,deflate],?If-Modified-Since:Accept-Language,Accept-Charset:Googlebot","1:Host,Connection=[Keep-alive],Accept=[text/plain],Accept=[text/html],From=[googlebot(at)googlebot.com],User-Agent,Accept-Encoding=[gzip,deflate]:Accept-Language,Accept-Charset:Googlebot"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,Connection=[Keep-alive],Accept=[*/*],From=[googlebot(at)googlebot.com],User-Agent,Accept-Encoding=[gzip,deflate],?If-Modified-Since:Accept-Language,Accept-Charset:Googlebot", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "Keep-alive", HasValue: true},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "From", Value: "googlebot(at)googlebot.com", HasValue: true},{Name: "User-Agent"},{Name: "Accept-Encoding", Value: "gzip,deflate", HasValue: true},{Name: "If-Modified-Since", Optional: true}}, Absent: []string{"Accept-Language","Accept-Charset"}, Software: "Googlebot"},
+{Raw: "1:Host,Connection=[Keep-alive],Accept=[text/plain],Accept=[text/html],From=[googlebot(at)googlebot.com],User-Agent,Accept-Encoding=[gzip,deflate]:Accept-Language,Accept-Charset:Googlebot", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "Keep-alive", HasValue: true},{Name: "Accept", Value: "text/plain", HasValue: true},{Name: "Accept", Value: "text/html", HasValue: true},{Name: "From", Value: "googlebot(at)googlebot.com", HasValue: true},{Name: "User-Agent"},{Name: "Accept-Encoding", Value: "gzip,deflate", HasValue: true}}, Absent: []string{"Accept-Language","Accept-Charset"}, Software: "Googlebot"},
+}},
+{Section: "http:request", Label: "s:!:Googlebot:feed fetcher", Class: "!", Name: "Googlebot", Flavor: "feed fetcher", Sys: []string{"Linux"}, Sig: []string{"1:Host,Connection=[Keep-alive],Accept=[*/*],User-Agent,Accept-Encoding=[gzip,deflate],?If-Modified-Since:Accept-Language,Accept-Charset:-Google","1:User-Agent,?X-shindig-dos=[on],Cache-Control,Host,?X-Forwarded-For,Accept-Encoding=[gzip],?Accept-Language:Connection,Accept,Accept-Charset:Feedfetcher-Google"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,Connection=[Keep-alive],Accept=[*/*],User-Agent,Accept-Encoding=[gzip,deflate],?If-Modified-Since:Accept-Language,Accept-Charset:-Google", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "Keep-alive", HasValue: true},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "User-Agent"},{Name: "Accept-Encoding", Value: "gzip,deflate", HasValue: true},{Name: "If-Modified-Since", Optional: true}}, Absent: []string{"Accept-Language","Accept-Charset"}, Software: "-Google"},
+{Raw: "1:User-Agent,?X-shindig-dos=[on],Cache-Control,Host,?X-Forwarded-For,Accept-Encoding=[gzip],?Accept-Language:Connection,Accept,Accept-Charset:Feedfetcher-Google", Version: 1, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "X-shindig-dos", Value: "on", HasValue: true, Optional: true},{Name: "Cache-Control"},{Name: "Host"},{Name: "X-Forwarded-For", Optional: true},{Name: "Accept-Encoding", Value: "gzip", HasValue: true},{Name: "Accept-Language", Optional: true}}, Absent: []string{"Connection","Accept","Accept-Charset"}, Software: "Feedfetcher-Google"},
+}},
+{Section: "http:request", Label: "s:!:Bingbot:", Class: "!", Name: "Bingbot", Flavor: "", Sys: []string{"Windows"}, Sig: []string{"1:Cache-Control,Connection=[Keep-Alive],Pragma=[no-cache],Accept=[*/*],Accept-Encoding,Host,User-Agent:Accept-Language,Accept-Charset:bingbot/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Cache-Control,Connection=[Keep-Alive],Pragma=[no-cache],Accept=[*/*],Accept-Encoding,Host,User-Agent:Accept-Language,Accept-Charset:bingbot/", Version: 1, Order: []HTTPSigHeader{{Name: "Cache-Control"},{Name: "Connection", Value: "Keep-Alive", HasValue: true},{Name: "Pragma", Value: "no-cache", HasValue: true},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Accept-Encoding"},{Name: "Host"},{Name: "User-Agent"}}, Absent: []string{"Accept-Language","Accept-Charset"}, Software: "bingbot/"},
+}},
+{Section: "http:request", Label: "s:!:MSNbot:", Class: "!", Name: "MSNbot", Flavor: "", Sys: []string{"Windows"}, Sig: []string{"1:Connection=[Close],Accept,Accept-Encoding=[gzip, deflate],From=[msnbot(at)microsoft.com],Host,User-Agent:Accept-Language,Accept-Charset:msnbot"},
+HTTP: []HTTPSignature{
+{Raw: "1:Connection=[Close],Accept,Accept-Encoding=[gzip, deflate],From=[msnbot(at)microsoft.com],Host,User-Agent:Accept-Language,Accept-Charset:msnbot", Version: 1, Order: []HTTPSigHeader{{Name: "Connection", Value: "Close", HasValue: true},{Name: "Accept"},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "From", Value: "msnbot(at)microsoft.com", HasValue: true},{Name: "Host"},{Name: "User-Agent"}}, Absent: []string{"Accept-Language","Accept-Charset"}, Software: "msnbot"},
+}},
+{Section: "http:request", Label: "s:!:Yandex:crawler", Class: "!", Name: "Yandex", Flavor: "crawler", Sys: []string{"FreeBSD"}, Sig: []string{"1:Host,Connection=[Keep-Alive],Accept=[*/*],Accept-Encoding=[gzip,deflate],Accept-Language=[en-us, en;q=0.7, *;q=0.01],User-Agent,From=[support@search.yandex.ru]:Accept-Charset:YandexBot/","1:Host,Connection=[Keep-Alive],Accept=[image/jpeg, image/pjpeg, image/png, image/gif],User-Agent,From=[support@search.yandex.ru]:Accept-Encoding,Accept-Language,Accept-Charset:YandexImages/","1:Host,Connection=[Keep-Alive],User-Agent,From=[support@search.yandex.ru]:Accept,Accept-Encoding,Accept-Language,Accept-Charset:YandexBot/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,Connection=[Keep-Alive],Accept=[*/*],Accept-Encoding=[gzip,deflate],Accept-Language=[en-us, en;q=0.7, *;q=0.01],User-Agent,From=[support@search.yandex.ru]:Accept-Charset:YandexBot/", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "Keep-Alive", HasValue: true},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Accept-Encoding", Value: "gzip,deflate", HasValue: true},{Name: "Accept-Language", Value: "en-us, en;q=0.7, *;q=0.01", HasValue: true},{Name: "User-Agent"},{Name: "From", Value: "support@search.yandex.ru", HasValue: true}}, Absent: []string{"Accept-Charset"}, Software: "YandexBot/"},
+{Raw: "1:Host,Connection=[Keep-Alive],Accept=[image/jpeg, image/pjpeg, image/png, image/gif],User-Agent,From=[support@search.yandex.ru]:Accept-Encoding,Accept-Language,Accept-Charset:YandexImages/", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "Keep-Alive", HasValue: true},{Name: "Accept", Value: "image/jpeg, image/pjpeg, image/png, image/gif", HasValue: true},{Name: "User-Agent"},{Name: "From", Value: "support@search.yandex.ru", HasValue: true}}, Absent: []string{"Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "YandexImages/"},
+{Raw: "1:Host,Connection=[Keep-Alive],User-Agent,From=[support@search.yandex.ru]:Accept,Accept-Encoding,Accept-Language,Accept-Charset:YandexBot/", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "Keep-Alive", HasValue: true},{Name: "User-Agent"},{Name: "From", Value: "support@search.yandex.ru", HasValue: true}}, Absent: []string{"Accept","Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "YandexBot/"},
+}},
+{Section: "http:request", Label: "s:!:Yahoo:crawler", Class: "!", Name: "Yahoo", Flavor: "crawler", Sys: []string{"Linux"}, Sig: []string{"0:Host,User-Agent,Accept=[,image/png,*/*;q=0.5],Accept-Language=[en-us,en;q=0.5],Accept-Encoding=[gzip],Accept-Charset=[,utf-8;q=0.7,*;q=0.7]:Connection:Slurp"},
+HTTP: []HTTPSignature{
+{Raw: "0:Host,User-Agent,Accept=[,image/png,*/*;q=0.5],Accept-Language=[en-us,en;q=0.5],Accept-Encoding=[gzip],Accept-Charset=[,utf-8;q=0.7,*;q=0.7]:Connection:Slurp", Version: 0, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: ",image/png,*/*;q=0.5", HasValue: true},{Name: "Accept-Language", Value: "en-us,en;q=0.5", HasValue: true},{Name: "Accept-Encoding", Value: "gzip", HasValue: true},{Name: "Accept-Charset", Value: ",utf-8;q=0.7,*;q=0.7", HasValue: true}}, Absent: []string{"Connection"}, Software: "Slurp"},
+}},
+{Section: "http:request", Label: "s:!:Flipboard:crawler", Class: "!", Name: "Flipboard", Flavor: "crawler", Sys: []string{"Linux"}, Sig: []string{"1:User-Agent,Accept-Language=[en-us,en;q=0.5],Accept-Charset=[;q=0.7,*;q=0.5],Accept-Encoding=[gzip],Host,Accept=[*; q=.2, */*; q=.2],Connection=[keep-alive]::FlipboardProxy","1:Accept-language=[en-us,en;q=0.5],Accept-encoding=[gzip],Accept=[;q=0.9,*/*;q=0.8],User-agent,Host:User-Agent,Connection,Accept-Encoding,Accept-Language,Accept-Charset:FlipboardProxy"},
+HTTP: []HTTPSignature{
+{Raw: "1:User-Agent,Accept-Language=[en-us,en;q=0.5],Accept-Charset=[;q=0.7,*;q=0.5],Accept-Encoding=[gzip],Host,Accept=[*; q=.2, */*; q=.2],Connection=[keep-alive]::FlipboardProxy", Version: 1, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "Accept-Language", Value: "en-us,en;q=0.5", HasValue: true},{Name: "Accept-Charset", Value: ";q=0.7,*;q=0.5", HasValue: true},{Name: "Accept-Encoding", Value: "gzip", HasValue: true},{Name: "Host"},{Name: "Accept", Value: "*; q=.2, */*; q=.2", HasValue: true},{Name: "Connection", Value: "keep-alive", HasValue: true}}, Absent: nil, Software: "FlipboardProxy"},
+{Raw: "1:Accept-language=[en-us,en;q=0.5],Accept-encoding=[gzip],Accept=[;q=0.9,*/*;q=0.8],User-agent,Host:User-Agent,Connection,Accept-Encoding,Accept-Language,Accept-Charset:FlipboardProxy", Version: 1, Order: []HTTPSigHeader{{Name: "Accept-language", Value: "en-us,en;q=0.5", HasValue: true},{Name: "Accept-encoding", Value: "gzip", HasValue: true},{Name: "Accept", Value: ";q=0.9,*/*;q=0.8", HasValue: true},{Name: "User-agent"},{Name: "Host"}}, Absent: []string{"User-Agent","Connection","Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "FlipboardProxy"},
+}},
+{Section: "http:request", Label: "s:!:Spinn3r:crawler", Class: "!", Name: "Spinn3r", Flavor: "crawler", Sys: []string{"Linux"}, Sig: []string{"1:User-Agent,Accept-Encoding=[gzip],Host,Accept=[*; q=.2, */*; q=.2],Connection=[close]:Accept-Language,Accept-Charset:Spinn3r"},
+HTTP: []HTTPSignature{
+{Raw: "1:User-Agent,Accept-Encoding=[gzip],Host,Accept=[*; q=.2, */*; q=.2],Connection=[close]:Accept-Language,Accept-Charset:Spinn3r", Version: 1, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "Accept-Encoding", Value: "gzip", HasValue: true},{Name: "Host"},{Name: "Accept", Value: "*; q=.2, */*; q=.2", HasValue: true},{Name: "Connection", Value: "close", HasValue: true}}, Absent: []string{"Accept-Language","Accept-Charset"}, Software: "Spinn3r"},
+}},
+{Section: "http:request", Label: "s:!:Facebook:crawler", Class: "!", Name: "Facebook", Flavor: "crawler", Sys: []string{"Linux"}, Sig: []string{"1:User-Agent,Host,Accept=[*/*],Accept-Encoding=[deflate, gzip],Connection=[close]:Accept-Language,Accept-Charset:facebookexternalhit/","1:User-Agent,Host,Accept=[*/*],Connection=[close]:Accept-Encoding,Accept-Language,Accept-Charset:facebookexternalhit/"},
+HTTP: []HTTPSignature{
+{Raw: "1:User-Agent,Host,Accept=[*/*],Accept-Encoding=[deflate, gzip],Connection=[close]:Accept-Language,Accept-Charset:facebookexternalhit/", Version: 1, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "Host"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Accept-Encoding", Value: "deflate, gzip", HasValue: true},{Name: "Connection", Value: "close", HasValue: true}}, Absent: []string{"Accept-Language","Accept-Charset"}, Software: "facebookexternalhit/"},
+{Raw: "1:User-Agent,Host,Accept=[*/*],Connection=[close]:Accept-Encoding,Accept-Language,Accept-Charset:facebookexternalhit/", Version: 1, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "Host"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Connection", Value: "close", HasValue: true}}, Absent: []string{"Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "facebookexternalhit/"},
+}},
+{Section: "http:request", Label: "s:!:paper.li:crawler", Class: "!", Name: "paper.li", Flavor: "crawler", Sys: []string{"Linux"}, Sig: []string{"1:Accept-Language=[en-us,en;q=0.5],Accept=[*/*],User-Agent,Connection=[close],Accept-Encoding=[gzip,identity],?Referer,Host,Accept-Charset=[ISO-8859-1,utf-8;q=0.7,*;q=0.7]::PaperLiBot/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Accept-Language=[en-us,en;q=0.5],Accept=[*/*],User-Agent,Connection=[close],Accept-Encoding=[gzip,identity],?Referer,Host,Accept-Charset=[ISO-8859-1,utf-8;q=0.7,*;q=0.7]::PaperLiBot/", Version: 1, Order: []HTTPSigHeader{{Name: "Accept-Language", Value: "en-us,en;q=0.5", HasValue: true},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "User-Agent"},{Name: "Connection", Value: "close", HasValue: true},{Name: "Accept-Encoding", Value: "gzip,identity", HasValue: true},{Name: "Referer", Optional: true},{Name: "Host"},{Name: "Accept-Charset", Value: "ISO-8859-1,utf-8;q=0.7,*;q=0.7", HasValue: true}}, Absent: nil, Software: "PaperLiBot/"},
+}},
+{Section: "http:request", Label: "s:!:Twitter:crawler", Class: "!", Name: "Twitter", Flavor: "crawler", Sys: []string{"Linux"}, Sig: []string{"1:User-Agent=[Twitterbot/],Host,Accept=[*; q=.2, */*; q=.2],Cache-Control,Connection=[keep-alive]:Accept-Encoding,Accept-Language,Accept-Charset:Twitterbot/"},
+HTTP: []HTTPSignature{
+{Raw: "1:User-Agent=[Twitterbot/],Host,Accept=[*; q=.2, */*; q=.2],Cache-Control,Connection=[keep-alive]:Accept-Encoding,Accept-Language,Accept-Charset:Twitterbot/", Version: 1, Order: []HTTPSigHeader{{Name: "User-Agent", Value: "Twitterbot/", HasValue: true},{Name: "Host"},{Name: "Accept", Value: "*; q=.2, */*; q=.2", HasValue: true},{Name: "Cache-Control"},{Name: "Connection", Value: "keep-alive", HasValue: true}}, Absent: []string{"Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "Twitterbot/"},
+}},
+{Section: "http:request", Label: "s:!:linkdex:crawler", Class: "!", Name: "linkdex", Flavor: "crawler", Sys: []string{"Linux"}, Sig: []string{"0:Host,Connection=[Keep-Alive],User-Agent,Accept-Encoding=[gzip,deflate]:Accept,Accept-Language,Accept-Charset:linkdex.com/"},
+HTTP: []HTTPSignature{
+{Raw: "0:Host,Connection=[Keep-Alive],User-Agent,Accept-Encoding=[gzip,deflate]:Accept,Accept-Language,Accept-Charset:linkdex.com/", Version: 0, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Connection", Value: "Keep-Alive", HasValue: true},{Name: "User-Agent"},{Name: "Accept-Encoding", Value: "gzip,deflate", HasValue: true}}, Absent: []string{"Accept","Accept-Language","Accept-Charset"}, Software: "linkdex.com/"},
+}},
+{Section: "http:request", Label: "s:!:Yodaobot:", Class: "!", Name: "Yodaobot", Flavor: "", Sys: []string{"Linux"}, Sig: []string{"1:Accept-Encoding=[identity;q=0.5, *;q=0.1],User-Agent,Host:Connection,Accept,Accept-Language,Accept-Charset:YodaoBot/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Accept-Encoding=[identity;q=0.5, *;q=0.1],User-Agent,Host:Connection,Accept,Accept-Language,Accept-Charset:YodaoBot/", Version: 1, Order: []HTTPSigHeader{{Name: "Accept-Encoding", Value: "identity;q=0.5, *;q=0.1", HasValue: true},{Name: "User-Agent"},{Name: "Host"}}, Absent: []string{"Connection","Accept","Accept-Language","Accept-Charset"}, Software: "YodaoBot/"},
+}},
+{Section: "http:request", Label: "s:!:Tweetmeme:crawler", Class: "!", Name: "Tweetmeme", Flavor: "crawler", Sys: []string{"Linux"}, Sig: []string{"1:Host,User-Agent,Accept=[,image/png,*/*;q=0.5],Accept-Language=[en-gb,en;q=0.5],Accept-Charset=[ISO-8859-1,utf-8;q=0.7,*;q=0.7]:Connection,Accept-Encoding:TweetmemeBot/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,User-Agent,Accept=[,image/png,*/*;q=0.5],Accept-Language=[en-gb,en;q=0.5],Accept-Charset=[ISO-8859-1,utf-8;q=0.7,*;q=0.7]:Connection,Accept-Encoding:TweetmemeBot/", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: ",image/png,*/*;q=0.5", HasValue: true},{Name: "Accept-Language", Value: "en-gb,en;q=0.5", HasValue: true},{Name: "Accept-Charset", Value: "ISO-8859-1,utf-8;q=0.7,*;q=0.7", HasValue: true}}, Absent: []string{"Connection","Accept-Encoding"}, Software: "TweetmemeBot/"},
+}},
+{Section: "http:request", Label: "s:!:Archive.org:crawler", Class: "!", Name: "Archive.org", Flavor: "crawler", Sys: []string{"Linux"}, Sig: []string{"0:User-Agent,Connection=[close],Accept=[application/xml;q=0.9,*/*;q=0.8],Host:Accept-Encoding,Accept-Language,Accept-Charset:archive.org"},
+HTTP: []HTTPSignature{
+{Raw: "0:User-Agent,Connection=[close],Accept=[application/xml;q=0.9,*/*;q=0.8],Host:Accept-Encoding,Accept-Language,Accept-Charset:archive.org", Version: 0, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "Connection", Value: "close", HasValue: true},{Name: "Accept", Value: "application/xml;q=0.9,*/*;q=0.8", HasValue: true},{Name: "Host"}}, Absent: []string{"Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "archive.org"},
+}},
+{Section: "http:request", Label: "s:!:Yahoo Pipes:", Class: "!", Name: "Yahoo Pipes", Flavor: "", Sys: []string{"Linux"}, Sig: []string{"0:Client-IP,X-Forwarded-For,X-YQL-Depth,User-Agent,Host,Connection=[keep-alive],Via:Accept,Accept-Encoding,Accept-Language,Accept-Charset:Yahoo Pipes","1:Client-IP,X-Forwarded-For,X-YQL-Depth,User-Agent,Host,Via:Connection,Accept,Accept-Encoding,Accept-Language,Accept-Charset:Yahoo Pipes"},
+HTTP: []HTTPSignature{
+{Raw: "0:Client-IP,X-Forwarded-For,X-YQL-Depth,User-Agent,Host,Connection=[keep-alive],Via:Accept,Accept-Encoding,Accept-Language,Accept-Charset:Yahoo Pipes", Version: 0, Order: []HTTPSigHeader{{Name: "Client-IP"},{Name: "X-Forwarded-For"},{Name: "X-YQL-Depth"},{Name: "User-Agent"},{Name: "Host"},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Via"}}, Absent: []string{"Accept","Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "Yahoo Pipes"},
+{Raw: "1:Client-IP,X-Forwarded-For,X-YQL-Depth,User-Agent,Host,Via:Connection,Accept,Accept-Encoding,Accept-Language,Accept-Charset:Yahoo Pipes", Version: 1, Order: []HTTPSigHeader{{Name: "Client-IP"},{Name: "X-Forwarded-For"},{Name: "X-YQL-Depth"},{Name: "User-Agent"},{Name: "Host"},{Name: "Via"}}, Absent: []string{"Connection","Accept","Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "Yahoo Pipes"},
+}},
+{Section: "http:request", Label: "s:!:Google Web Preview:", Class: "!", Name: "Google Web Preview", Flavor: "", Sys: []string{"Linux"}, Sig: []string{"1:Referer,User-Agent,Accept-Encoding=[gzip,deflate],Host,X-Forwarded-For:Connection,Accept,Accept-Language,Accept-Charset:Web Preview"},
+HTTP: []HTTPSignature{
+{Raw: "1:Referer,User-Agent,Accept-Encoding=[gzip,deflate],Host,X-Forwarded-For:Connection,Accept,Accept-Language,Accept-Charset:Web Preview", Version: 1, Order: []HTTPSigHeader{{Name: "Referer"},{Name: "User-Agent"},{Name: "Accept-Encoding", Value: "gzip,deflate", HasValue: true},{Name: "Host"},{Name: "X-Forwarded-For"}}, Absent: []string{"Connection","Accept","Accept-Language","Accept-Charset"}, Software: "Web Preview"},
+}},
+{Section: "http:request", Label: "s:!:wget:", Class: "!", Name: "wget", Flavor: "", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX","Windows"}, Sig: []string{"*:User-Agent,Accept=[*/*],Host,Connection=[Keep-Alive]:Accept-Encoding,Accept-Language,Accept-Charset:Wget/"},
+HTTP: []HTTPSignature{
+{Raw: "*:User-Agent,Accept=[*/*],Host,Connection=[Keep-Alive]:Accept-Encoding,Accept-Language,Accept-Charset:Wget/", Version: Any, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Host"},{Name: "Connection", Value: "Keep-Alive", HasValue: true}}, Absent: []string{"Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "Wget/"},
+}},
+{Section: "http:request", Label: "s:!:Lynx:", Class: "!", Name: "Lynx", Flavor: "", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX","Windows"}, Sig: []string{"0:Host,Accept=[text/sgml, */*;q=0.01],Accept-Encoding=[gzip, compress],Accept-Language,User-Agent:Connection,Accept-Charset:Lynx/"},
+HTTP: []HTTPSignature{
+{Raw: "0:Host,Accept=[text/sgml, */*;q=0.01],Accept-Encoding=[gzip, compress],Accept-Language,User-Agent:Connection,Accept-Charset:Lynx/", Version: 0, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Accept", Value: "text/sgml, */*;q=0.01", HasValue: true},{Name: "Accept-Encoding", Value: "gzip, compress", HasValue: true},{Name: "Accept-Language"},{Name: "User-Agent"}}, Absent: []string{"Connection","Accept-Charset"}, Software: "Lynx/"},
+}},
+{Section: "http:request", Label: "s:!:curl:", Class: "!", Name: "curl", Flavor: "", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX","Windows"}, Sig: []string{"1:User-Agent,Host,Accept=[*/*]:Connection,Accept-Encoding,Accept-Language,Accept-Charset:curl/"},
+HTTP: []HTTPSignature{
+{Raw: "1:User-Agent,Host,Accept=[*/*]:Connection,Accept-Encoding,Accept-Language,Accept-Charset:curl/", Version: 1, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "Host"},{Name: "Accept", Value: "*/*", HasValue: true}}, Absent: []string{"Connection","Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "curl/"},
+}},
+{Section: "http:request", Label: "s:!:links:", Class: "!", Name: "links", Flavor: "", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX","Windows"}, Sig: []string{"1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[gzip, deflate, bzip2],Accept-Charset=[us-ascii],Accept-Language=[;q=0.1],Connection=[Keep-Alive]::Links","1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[gzip,deflate,bzip2],Accept-Charset=[us-ascii],Accept-Language=[;q=0.1],Connection=[keep-alive]::Links"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[gzip, deflate, bzip2],Accept-Charset=[us-ascii],Accept-Language=[;q=0.1],Connection=[Keep-Alive]::Links", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Accept-Encoding", Value: "gzip, deflate, bzip2", HasValue: true},{Name: "Accept-Charset", Value: "us-ascii", HasValue: true},{Name: "Accept-Language", Value: ";q=0.1", HasValue: true},{Name: "Connection", Value: "Keep-Alive", HasValue: true}}, Absent: nil, Software: "Links"},
+{Raw: "1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[gzip,deflate,bzip2],Accept-Charset=[us-ascii],Accept-Language=[;q=0.1],Connection=[keep-alive]::Links", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Accept-Encoding", Value: "gzip,deflate,bzip2", HasValue: true},{Name: "Accept-Charset", Value: "us-ascii", HasValue: true},{Name: "Accept-Language", Value: ";q=0.1", HasValue: true},{Name: "Connection", Value: "keep-alive", HasValue: true}}, Absent: nil, Software: "Links"},
+}},
+{Section: "http:request", Label: "s:!:elinks:", Class: "!", Name: "elinks", Flavor: "", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX","Windows"}, Sig: []string{"1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[bzip2, deflate, gzip],Accept-Language:Connection,Accept-Charset:ELinks/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[bzip2, deflate, gzip],Accept-Language:Connection,Accept-Charset:ELinks/", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Accept-Encoding", Value: "bzip2, deflate, gzip", HasValue: true},{Name: "Accept-Language"}}, Absent: []string{"Connection","Accept-Charset"}, Software: "ELinks/"},
+}},
+{Section: "http:request", Label: "s:!:Java:JRE", Class: "!", Name: "Java", Flavor: "JRE", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX","Windows"}, Sig: []string{"1:User-Agent,Host,Accept=[*; q=.2, */*; q=.2],Connection=[keep-alive]:Accept-Encoding,Accept-Language,Accept-Charset:Java/"},
+HTTP: []HTTPSignature{
+{Raw: "1:User-Agent,Host,Accept=[*; q=.2, */*; q=.2],Connection=[keep-alive]:Accept-Encoding,Accept-Language,Accept-Charset:Java/", Version: 1, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "Host"},{Name: "Accept", Value: "*; q=.2, */*; q=.2", HasValue: true},{Name: "Connection", Value: "keep-alive", HasValue: true}}, Absent: []string{"Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "Java/"},
+}},
+{Section: "http:request", Label: "s:!:Python:urllib", Class: "!", Name: "Python", Flavor: "urllib", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX","Windows"}, Sig: []string{"1:Accept-Encoding=[identity],Host,Connection=[close],User-Agent:Accept,Accept-Language,Accept-Charset:Python-urllib/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Accept-Encoding=[identity],Host,Connection=[close],User-Agent:Accept,Accept-Language,Accept-Charset:Python-urllib/", Version: 1, Order: []HTTPSigHeader{{Name: "Accept-Encoding", Value: "identity", HasValue: true},{Name: "Host"},{Name: "Connection", Value: "close", HasValue: true},{Name: "User-Agent"}}, Absent: []string{"Accept","Accept-Language","Accept-Charset"}, Software: "Python-urllib/"},
+}},
+{Section: "http:request", Label: "s:!:w3m:", Class: "!", Name: "w3m", Flavor: "", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX","Windows"}, Sig: []string{"0:User-Agent,Accept=[image/*],Accept-Encoding=[gzip, compress, bzip, bzip2, deflate],Accept-Language=[;q=1.0],Host:Connection,Accept-Charset:w3m/"},
+HTTP: []HTTPSignature{
+{Raw: "0:User-Agent,Accept=[image/*],Accept-Encoding=[gzip, compress, bzip, bzip2, deflate],Accept-Language=[;q=1.0],Host:Connection,Accept-Charset:w3m/", Version: 0, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "Accept", Value: "image/*", HasValue: true},{Name: "Accept-Encoding", Value: "gzip, compress, bzip, bzip2, deflate", HasValue: true},{Name: "Accept-Language", Value: ";q=1.0", HasValue: true},{Name: "Host"}}, Absent: []string{"Connection","Accept-Charset"}, Software: "w3m/"},
+}},
+{Section: "http:request", Label: "s:!:libfetch:", Class: "!", Name: "libfetch", Flavor: "", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:Host,User-Agent,Connection=[close]:Accept,Accept-Encoding,Accept-Language,Accept-Charset:libfetch/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,User-Agent,Connection=[close]:Accept,Accept-Encoding,Accept-Language,Accept-Charset:libfetch/", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Connection", Value: "close", HasValue: true}}, Absent: []string{"Accept","Accept-Encoding","Accept-Language","Accept-Charset"}, Software: "libfetch/"},
+}},
+{Section: "http:request", Label: "s:!:Google AppEngine:", Class: "!", Name: "Google AppEngine", Flavor: "", Sys: []string{"Linux"}, Sig: []string{"1:User-Agent,Host,Accept-Encoding=[gzip]:Connection,Accept,Accept-Language,Accept-Charset:AppEngine-Google"},
+HTTP: []HTTPSignature{
+{Raw: "1:User-Agent,Host,Accept-Encoding=[gzip]:Connection,Accept,Accept-Language,Accept-Charset:AppEngine-Google", Version: 1, Order: []HTTPSigHeader{{Name: "User-Agent"},{Name: "Host"},{Name: "Accept-Encoding", Value: "gzip", HasValue: true}}, Absent: []string{"Connection","Accept","Accept-Language","Accept-Charset"}, Software: "AppEngine-Google"},
+}},
+{Section: "http:request", Label: "s:!:WebOS:", Class: "!", Name: "WebOS", Flavor: "", Sys: []string{"Linux"}, Sig: []string{"1:Host,Accept-Encoding=[gzip, deflate],User-Agent,Accept=[,*/*;q=0.5],Accept-Language,Accept-Charset=[utf-8;q=0.7,*;q=0.3]:Connection:wOSBrowser"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,Accept-Encoding=[gzip, deflate],User-Agent,Accept=[,*/*;q=0.5],Accept-Language,Accept-Charset=[utf-8;q=0.7,*;q=0.3]:Connection:wOSBrowser", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "Accept-Encoding", Value: "gzip, deflate", HasValue: true},{Name: "User-Agent"},{Name: "Accept", Value: ",*/*;q=0.5", HasValue: true},{Name: "Accept-Language"},{Name: "Accept-Charset", Value: "utf-8;q=0.7,*;q=0.3", HasValue: true}}, Absent: []string{"Connection"}, Software: "wOSBrowser"},
+}},
+{Section: "http:request", Label: "s:!:xxxterm:", Class: "!", Name: "xxxterm", Flavor: "", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[gzip]:Connection,Accept-Language,Accept-Charset:xxxterm"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[gzip]:Connection,Accept-Language,Accept-Charset:xxxterm", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Accept-Encoding", Value: "gzip", HasValue: true}}, Absent: []string{"Connection","Accept-Language","Accept-Charset"}, Software: "xxxterm"},
+}},
+{Section: "http:request", Label: "s:!:Google Desktop:", Class: "!", Name: "Google Desktop", Flavor: "", Sys: []string{"Windows"}, Sig: []string{"1:Accept=[*/*],Accept-Encoding=[gzip],User-Agent,Host,Connection=[Keep-Alive]:Accept-Language,Accept-Charset:Google Desktop/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Accept=[*/*],Accept-Encoding=[gzip],User-Agent,Host,Connection=[Keep-Alive]:Accept-Language,Accept-Charset:Google Desktop/", Version: 1, Order: []HTTPSigHeader{{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Accept-Encoding", Value: "gzip", HasValue: true},{Name: "User-Agent"},{Name: "Host"},{Name: "Connection", Value: "Keep-Alive", HasValue: true}}, Absent: []string{"Accept-Language","Accept-Charset"}, Software: "Google Desktop/"},
+}},
+{Section: "http:request", Label: "s:!:luakit:", Class: "!", Name: "luakit", Flavor: "", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[gzip],Connection=[Keep-Alive]:Accept-Language,Accept-Charset:luakit"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[gzip],Connection=[Keep-Alive]:Accept-Language,Accept-Charset:luakit", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Accept-Encoding", Value: "gzip", HasValue: true},{Name: "Connection", Value: "Keep-Alive", HasValue: true}}, Absent: []string{"Accept-Language","Accept-Charset"}, Software: "luakit"},
+}},
+{Section: "http:request", Label: "s:!:Epiphany:", Class: "!", Name: "Epiphany", Flavor: "", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[gzip],Accept-Language:Connection,Accept-Charset,Keep-Alive:Epiphany/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Host,User-Agent,Accept=[*/*],Accept-Encoding=[gzip],Accept-Language:Connection,Accept-Charset,Keep-Alive:Epiphany/", Version: 1, Order: []HTTPSigHeader{{Name: "Host"},{Name: "User-Agent"},{Name: "Accept", Value: "*/*", HasValue: true},{Name: "Accept-Encoding", Value: "gzip", HasValue: true},{Name: "Accept-Language"}}, Absent: []string{"Connection","Accept-Charset","Keep-Alive"}, Software: "Epiphany/"},
+}},
+{Section: "http:response", Label: "s:!:Apache:2.x", Class: "!", Name: "Apache", Flavor: "2.x", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX","Windows"}, Sig: []string{"1:Date,Server,?Last-Modified,?Accept-Ranges=[bytes],?Content-Length,?Content-Range,Keep-Alive=[timeout],Connection=[Keep-Alive],?Transfer-Encoding=[chunked],Content-Type::Apache","1:Date,Server,?Last-Modified,?Accept-Ranges=[bytes],?Content-Length,?Connection=[close],?Transfer-Encoding=[chunked],Content-Type:Keep-Alive:Apache","1:Date,Server,Connection=[Keep-Alive],Keep-Alive=[timeout]:Content-Type,Accept-Ranges:Apache","1:Date,Server,?Last-Modified,?Accept-Ranges=[bytes],?Content-Length,Content-Type,Keep-Alive=[timeout],Connection=[Keep-Alive]::Apache"},
+HTTP: []HTTPSignature{
+{Raw: "1:Date,Server,?Last-Modified,?Accept-Ranges=[bytes],?Content-Length,?Content-Range,Keep-Alive=[timeout],Connection=[Keep-Alive],?Transfer-Encoding=[chunked],Content-Type::Apache", Version: 1, Order: []HTTPSigHeader{{Name: "Date"},{Name: "Server"},{Name: "Last-Modified", Optional: true},{Name: "Accept-Ranges", Value: "bytes", HasValue: true, Optional: true},{Name: "Content-Length", Optional: true},{Name: "Content-Range", Optional: true},{Name: "Keep-Alive", Value: "timeout", HasValue: true},{Name: "Connection", Value: "Keep-Alive", HasValue: true},{Name: "Transfer-Encoding", Value: "chunked", HasValue: true, Optional: true},{Name: "Content-Type"}}, Absent: nil, Software: "Apache"},
+{Raw: "1:Date,Server,?Last-Modified,?Accept-Ranges=[bytes],?Content-Length,?Connection=[close],?Transfer-Encoding=[chunked],Content-Type:Keep-Alive:Apache", Version: 1, Order: []HTTPSigHeader{{Name: "Date"},{Name: "Server"},{Name: "Last-Modified", Optional: true},{Name: "Accept-Ranges", Value: "bytes", HasValue: true, Optional: true},{Name: "Content-Length", Optional: true},{Name: "Connection", Value: "close", HasValue: true, Optional: true},{Name: "Transfer-Encoding", Value: "chunked", HasValue: true, Optional: true},{Name: "Content-Type"}}, Absent: []string{"Keep-Alive"}, Software: "Apache"},
+{Raw: "1:Date,Server,Connection=[Keep-Alive],Keep-Alive=[timeout]:Content-Type,Accept-Ranges:Apache", Version: 1, Order: []HTTPSigHeader{{Name: "Date"},{Name: "Server"},{Name: "Connection", Value: "Keep-Alive", HasValue: true},{Name: "Keep-Alive", Value: "timeout", HasValue: true}}, Absent: []string{"Content-Type","Accept-Ranges"}, Software: "Apache"},
+{Raw: "1:Date,Server,?Last-Modified,?Accept-Ranges=[bytes],?Content-Length,Content-Type,Keep-Alive=[timeout],Connection=[Keep-Alive]::Apache", Version: 1, Order: []HTTPSigHeader{{Name: "Date"},{Name: "Server"},{Name: "Last-Modified", Optional: true},{Name: "Accept-Ranges", Value: "bytes", HasValue: true, Optional: true},{Name: "Content-Length", Optional: true},{Name: "Content-Type"},{Name: "Keep-Alive", Value: "timeout", HasValue: true},{Name: "Connection", Value: "Keep-Alive", HasValue: true}}, Absent: nil, Software: "Apache"},
+}},
+{Section: "http:response", Label: "s:!:Apache:1.x", Class: "!", Name: "Apache", Flavor: "1.x", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX","Windows"}, Sig: []string{"1:Server,Content-Type,?Content-Length,Date,Connection=[keep-alive]:Keep-Alive,Accept-Ranges:Apache","1:Server,Content-Type,?Content-Length,Date,Connection=[close]:Keep-Alive,Accept-Ranges:Apache"},
+HTTP: []HTTPSignature{
+{Raw: "1:Server,Content-Type,?Content-Length,Date,Connection=[keep-alive]:Keep-Alive,Accept-Ranges:Apache", Version: 1, Order: []HTTPSigHeader{{Name: "Server"},{Name: "Content-Type"},{Name: "Content-Length", Optional: true},{Name: "Date"},{Name: "Connection", Value: "keep-alive", HasValue: true}}, Absent: []string{"Keep-Alive","Accept-Ranges"}, Software: "Apache"},
+{Raw: "1:Server,Content-Type,?Content-Length,Date,Connection=[close]:Keep-Alive,Accept-Ranges:Apache", Version: 1, Order: []HTTPSigHeader{{Name: "Server"},{Name: "Content-Type"},{Name: "Content-Length", Optional: true},{Name: "Date"},{Name: "Connection", Value: "close", HasValue: true}}, Absent: []string{"Keep-Alive","Accept-Ranges"}, Software: "Apache"},
+}},
+{Section: "http:response", Label: "s:!:IIS:7.x", Class: "!", Name: "IIS", Flavor: "7.x", Sys: []string{"Windows"}, Sig: []string{"1:?Content-Length,Content-Type,?Etag,Server,Date:Connection,Keep-Alive,Accept-Ranges:Microsoft-IIS/","1:?Content-Length,Content-Type,?Etag,Server,Date,Connection=[close]:Keep-Alive,Accept-Ranges:Microsoft-IIS/"},
+HTTP: []HTTPSignature{
+{Raw: "1:?Content-Length,Content-Type,?Etag,Server,Date:Connection,Keep-Alive,Accept-Ranges:Microsoft-IIS/", Version: 1, Order: []HTTPSigHeader{{Name: "Content-Length", Optional: true},{Name: "Content-Type"},{Name: "Etag", Optional: true},{Name: "Server"},{Name: "Date"}}, Absent: []string{"Connection","Keep-Alive","Accept-Ranges"}, Software: "Microsoft-IIS/"},
+{Raw: "1:?Content-Length,Content-Type,?Etag,Server,Date,Connection=[close]:Keep-Alive,Accept-Ranges:Microsoft-IIS/", Version: 1, Order: []HTTPSigHeader{{Name: "Content-Length", Optional: true},{Name: "Content-Type"},{Name: "Etag", Optional: true},{Name: "Server"},{Name: "Date"},{Name: "Connection", Value: "close", HasValue: true}}, Absent: []string{"Keep-Alive","Accept-Ranges"}, Software: "Microsoft-IIS/"},
+}},
+{Section: "http:response", Label: "s:!:lighttpd:2.x", Class: "!", Name: "lighttpd", Flavor: "2.x", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:?ETag,?Last-Modified,Accept-Ranges=[bytes],Content-Type,?Vary,?Content-Length,Date,Server:Connection,Keep-Alive:lighttpd/","1:?ETag,?Last-Modified,Transfer-Encoding=[chunked],Content-Type,?Vary,?Content-Length,Date,Server:Connection,Keep-Alive:lighttpd/"},
+HTTP: []HTTPSignature{
+{Raw: "1:?ETag,?Last-Modified,Accept-Ranges=[bytes],Content-Type,?Vary,?Content-Length,Date,Server:Connection,Keep-Alive:lighttpd/", Version: 1, Order: []HTTPSigHeader{{Name: "ETag", Optional: true},{Name: "Last-Modified", Optional: true},{Name: "Accept-Ranges", Value: "bytes", HasValue: true},{Name: "Content-Type"},{Name: "Vary", Optional: true},{Name: "Content-Length", Optional: true},{Name: "Date"},{Name: "Server"}}, Absent: []string{"Connection","Keep-Alive"}, Software: "lighttpd/"},
+{Raw: "1:?ETag,?Last-Modified,Transfer-Encoding=[chunked],Content-Type,?Vary,?Content-Length,Date,Server:Connection,Keep-Alive:lighttpd/", Version: 1, Order: []HTTPSigHeader{{Name: "ETag", Optional: true},{Name: "Last-Modified", Optional: true},{Name: "Transfer-Encoding", Value: "chunked", HasValue: true},{Name: "Content-Type"},{Name: "Vary", Optional: true},{Name: "Content-Length", Optional: true},{Name: "Date"},{Name: "Server"}}, Absent: []string{"Connection","Keep-Alive"}, Software: "lighttpd/"},
+}},
+{Section: "http:response", Label: "s:!:lighttpd:1.x", Class: "!", Name: "lighttpd", Flavor: "1.x", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:Content-Type,Accept-Ranges=[bytes],?ETag,?Last-Modified,Date,Server:Connection,Keep-Alive:lighttpd/","1:Content-Type,Transfer-Encoding=[chunked],?ETag,?Last-Modified,Date,Server:Connection,Keep-Alive:lighttpd/","0:Content-Type,Content-Length,Connection=[close],Date,Server:Keep-Alive,Accept-Ranges:lighttpd/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Content-Type,Accept-Ranges=[bytes],?ETag,?Last-Modified,Date,Server:Connection,Keep-Alive:lighttpd/", Version: 1, Order: []HTTPSigHeader{{Name: "Content-Type"},{Name: "Accept-Ranges", Value: "bytes", HasValue: true},{Name: "ETag", Optional: true},{Name: "Last-Modified", Optional: true},{Name: "Date"},{Name: "Server"}}, Absent: []string{"Connection","Keep-Alive"}, Software: "lighttpd/"},
+{Raw: "1:Content-Type,Transfer-Encoding=[chunked],?ETag,?Last-Modified,Date,Server:Connection,Keep-Alive:lighttpd/", Version: 1, Order: []HTTPSigHeader{{Name: "Content-Type"},{Name: "Transfer-Encoding", Value: "chunked", HasValue: true},{Name: "ETag", Optional: true},{Name: "Last-Modified", Optional: true},{Name: "Date"},{Name: "Server"}}, Absent: []string{"Connection","Keep-Alive"}, Software: "lighttpd/"},
+{Raw: "0:Content-Type,Content-Length,Connection=[close],Date,Server:Keep-Alive,Accept-Ranges:lighttpd/", Version: 0, Order: []HTTPSigHeader{{Name: "Content-Type"},{Name: "Content-Length"},{Name: "Connection", Value: "close", HasValue: true},{Name: "Date"},{Name: "Server"}}, Absent: []string{"Keep-Alive","Accept-Ranges"}, Software: "lighttpd/"},
+}},
+{Section: "http:response", Label: "s:!:nginx:1.x", Class: "!", Name: "nginx", Flavor: "1.x", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:Server,Date,Content-Type,?Content-Length,?Last-Modified,Connection=[keep-alive],Keep-Alive=[timeout],Accept-Ranges=[bytes]::nginx/","1:Server,Date,Content-Type,?Content-Length,?Last-Modified,Connection=[close]:Keep-Alive,Accept-Ranges:nginx/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Server,Date,Content-Type,?Content-Length,?Last-Modified,Connection=[keep-alive],Keep-Alive=[timeout],Accept-Ranges=[bytes]::nginx/", Version: 1, Order: []HTTPSigHeader{{Name: "Server"},{Name: "Date"},{Name: "Content-Type"},{Name: "Content-Length", Optional: true},{Name: "Last-Modified", Optional: true},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Keep-Alive", Value: "timeout", HasValue: true},{Name: "Accept-Ranges", Value: "bytes", HasValue: true}}, Absent: nil, Software: "nginx/"},
+{Raw: "1:Server,Date,Content-Type,?Content-Length,?Last-Modified,Connection=[close]:Keep-Alive,Accept-Ranges:nginx/", Version: 1, Order: []HTTPSigHeader{{Name: "Server"},{Name: "Date"},{Name: "Content-Type"},{Name: "Content-Length", Optional: true},{Name: "Last-Modified", Optional: true},{Name: "Connection", Value: "close", HasValue: true}}, Absent: []string{"Keep-Alive","Accept-Ranges"}, Software: "nginx/"},
+}},
+{Section: "http:response", Label: "s:!:nginx:0.x", Class: "!", Name: "nginx", Flavor: "0.x", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:Server,Date,Content-Type,?Content-Length,Connection=[keep-alive],?Last-Modified:Keep-Alive,Accept-Ranges:nginx/","1:Server,Date,Content-Type,?Content-Length,Connection=[close],?Last-Modified:Keep-Alive,Accept-Ranges:nginx/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Server,Date,Content-Type,?Content-Length,Connection=[keep-alive],?Last-Modified:Keep-Alive,Accept-Ranges:nginx/", Version: 1, Order: []HTTPSigHeader{{Name: "Server"},{Name: "Date"},{Name: "Content-Type"},{Name: "Content-Length", Optional: true},{Name: "Connection", Value: "keep-alive", HasValue: true},{Name: "Last-Modified", Optional: true}}, Absent: []string{"Keep-Alive","Accept-Ranges"}, Software: "nginx/"},
+{Raw: "1:Server,Date,Content-Type,?Content-Length,Connection=[close],?Last-Modified:Keep-Alive,Accept-Ranges:nginx/", Version: 1, Order: []HTTPSigHeader{{Name: "Server"},{Name: "Date"},{Name: "Content-Type"},{Name: "Content-Length", Optional: true},{Name: "Connection", Value: "close", HasValue: true},{Name: "Last-Modified", Optional: true}}, Absent: []string{"Keep-Alive","Accept-Ranges"}, Software: "nginx/"},
+}},
+{Section: "http:response", Label: "s:!:tengine:", Class: "!", Name: "tengine", Flavor: "", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:Server,Date,Content-Type,Connection=[close],Vary,X-Powered-By,X-Log-Uid,X-Error-Code,PROC_NODE,LB_NODE,Content-Length::Tengine/"},
+HTTP: []HTTPSignature{
+{Raw: "1:Server,Date,Content-Type,Connection=[close],Vary,X-Powered-By,X-Log-Uid,X-Error-Code,PROC_NODE,LB_NODE,Content-Length::Tengine/", Version: 1, Order: []HTTPSigHeader{{Name: "Server"},{Name: "Date"},{Name: "Content-Type"},{Name: "Connection", Value: "close", HasValue: true},{Name: "Vary"},{Name: "X-Powered-By"},{Name: "X-Log-Uid"},{Name: "X-Error-Code"},{Name: "PROC_NODE"},{Name: "LB_NODE"},{Name: "Content-Length"}}, Absent: nil, Software: "Tengine/"},
+}},
+{Section: "http:response", Label: "s:!:pws:8.x", Class: "!", Name: "pws", Flavor: "8.x", Sys: []string{"Linux","Mac OS X","MacOS X","iOS","FreeBSD","OpenBSD","Solaris","OpenVMS","Tru64","p0f","BaiduSpider","HP-UX"}, Sig: []string{"1:Date,Server,X-Px,Cache-Control,Expires,Age,Content-Length,Content-Type,Last-Modified,X-Via-CDN,Connection=[close]::PWS"},
+HTTP: []HTTPSignature{
+{Raw: "1:Date,Server,X-Px,Cache-Control,Expires,Age,Content-Length,Content-Type,Last-Modified,X-Via-CDN,Connection=[close]::PWS", Version: 1, Order: []HTTPSigHeader{{Name: "Date"},{Name: "Server"},{Name: "X-Px"},{Name: "Cache-Control"},{Name: "Expires"},{Name: "Age"},{Name: "Content-Length"},{Name: "Content-Type"},{Name: "Last-Modified"},{Name: "X-Via-CDN"},{Name: "Connection", Value: "close", HasValue: true}}, Absent: nil, Software: "PWS"},
+}},
+{Section: "http:response", Label: "s:!:Google Web Server:", Class: "!", Name: "Google Web Server", Flavor: "", Sys: []string{"Linux"}, Sig: []string{"*:Content-Type,X-Content-Type-Options=[nosniff],Date,Server=[sffe]:Connection,Accept-Ranges,Keep-Alive,Connection:","*:Date,Content-Type,Server=[gws]:Connection,Accept-Ranges,Keep-Alive:","*:Content-Type,X-Content-Type-Options=[nosniff],Server=[GSE]:Connection,Accept-Ranges,Keep-Alive:"},
+HTTP: []HTTPSignature{
+{Raw: "*:Content-Type,X-Content-Type-Options=[nosniff],Date,Server=[sffe]:Connection,Accept-Ranges,Keep-Alive,Connection:", Version: Any, Order: []HTTPSigHeader{{Name: "Content-Type"},{Name: "X-Content-Type-Options", Value: "nosniff", HasValue: true},{Name: "Date"},{Name: "Server", Value: "sffe", HasValue: true}}, Absent: []string{"Connection","Accept-Ranges","Keep-Alive","Connection"}, Software: ""},
+{Raw: "*:Date,Content-Type,Server=[gws]:Connection,Accept-Ranges,Keep-Alive:", Version: Any, Order: []HTTPSigHeader{{Name: "Date"},{Name: "Content-Type"},{Name: "Server", Value: "gws", HasValue: true}}, Absent: []string{"Connection","Accept-Ranges","Keep-Alive"}, Software: ""},
+{Raw: "*:Content-Type,X-Content-Type-Options=[nosniff],Server=[GSE]:Connection,Accept-Ranges,Keep-Alive:", Version: Any, Order: []HTTPSigHeader{{Name: "Content-Type"},{Name: "X-Content-Type-Options", Value: "nosniff", HasValue: true},{Name: "Server", Value: "GSE", HasValue: true}}, Absent: []string{"Connection","Accept-Ranges","Keep-Alive"}, Software: ""},
+}},
 }}
@@ -1,6 +1,9 @@
 package p0f
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestDataNotEmpty(t *testing.T) {
 	if len(Data.Entries) == 0 {
@@ -18,3 +21,42 @@ func TestDataNotEmpty(t *testing.T) {
 		t.Fatalf("missing http:response section")
 	}
 }
+
+func TestDataDirectives(t *testing.T) {
+	if len(Data.Classes) != 3 || Data.Classes[1] != "unix" {
+		t.Fatalf("classes = %v", Data.Classes)
+	}
+	if got := UAOS("Mozilla/5.0 (iPhone; CPU iPhone OS 10_3 like Mac OS X)"); got != "iOS" {
+		t.Fatalf("UAOS(iPhone) = %q", got)
+	}
+	if got := UAOS("Mozilla/5.0 (X11; SunOS i86pc)"); got != "Solaris" {
+		t.Fatalf("UAOS(SunOS) = %q", got)
+	}
+	for _, e := range Data.Entries {
+		for _, s := range e.Sys {
+			if strings.HasPrefix(s, "@") {
+				t.Fatalf("%s: unexpanded class %q", e.Label, s)
+			}
+		}
+		if e.Label == "s:!:Firefox:2.x" && (!contains(e.Sys, "Windows") || !contains(e.Sys, "Linux") || !contains(e.Sys, "FreeBSD")) {
+			t.Fatalf("Firefox sys = %v", e.Sys)
+		}
+	}
+}
+
+func TestDataCompiled(t *testing.T) {
+	for _, e := range Data.Entries {
+		var n int
+		switch e.Section {
+		case "tcp:request", "tcp:response":
+			n = len(e.TCP)
+		case "http:request", "http:response":
+			n = len(e.HTTP)
+		default:
+			continue
+		}
+		if n != len(e.Sig) || e.Name == "" {
+			t.Fatalf("%s: %d of %d signatures compiled, name %q", e.Label, n, len(e.Sig), e.Name)
+		}
+	}
+}
@@ -1,252 +1,398 @@
 package p0f
 
-import (
-	"strconv"
-	"strings"
-)
+import "fmt"
 
-func Detect(m PacketMeta) string {
-	bestClass := matchP0fSignature(m.TTL, int(m.Win), m.WScale, int(m.MSS), strings.Join(m.Options, ","))
-	if bestClass == "" {
-		return "Unknown"
-	}
-	return bestClass
+type Result struct {
+	Label   string
+	Generic bool
+	Class   string
+	Name    string
+	Flavor  string
+	Sig     string
+	Score   float64
+	Fuzzy   bool
+	// Match is how the signature matched; Fuzzy is Match == MatchFuzzy.
+	Match    MatchKind
+	Distance int
+	// Confidence, set by DetectTopN, is 0..1: how close the score is to
+	// the best possible one, shared out among near-tied labels.
+	Confidence float64
 }
 
-func matchP0fSignature(ttlInit int, win int, wscale int, mss int, optLayout string) string {
-	if len(Data.Entries) == 0 {
-		return ""
-	}
-	winStr := strconv.Itoa(win)
-	if wscale > 0 {
-		winStr = winStr + "," + strconv.Itoa(wscale)
+// ScoringProfile sets the points the heuristic matcher awards per field.
+type ScoringProfile struct {
+	// Quirks is awarded when the quirk sets are equal.
+	Quirks float64
+	// TTL is awarded when the packet's TTL can have started at the
+	// signature's initial TTL.
+	TTL float64
+	// WinAny, WinExact, WinScale and WinNear are awarded for a "*" window,
+	// an equal window and scale, an equal window with another scale, and a
+	// window within WinTolerance (a fraction, 0.15 for 15%) of the
+	// signature's.
+	WinAny       float64
+	WinExact     float64
+	WinScale     float64
+	WinNear      float64
+	WinTolerance float64
+	// LayoutExact is awarded for an identical option layout. A different
+	// layout earns LayoutOverlap times the Jaccard similarity of the option
+	// sets, plus LayoutSimilar when that similarity is above 0.5.
+	LayoutExact   float64
+	LayoutOverlap float64
+	LayoutSimilar float64
+	// Exact only accepts signatures that match every field exactly, as p0f
+	// does before it falls back to fuzzy matching: no tolerated quirks, no
+	// near window, no other option layout.
+	Exact bool
+}
+
+// DefaultProfile is the scoring used when Options.Profile is nil.
+var DefaultProfile = ScoringProfile{
+	Quirks:        2,
+	TTL:           3,
+	WinAny:        2,
+	WinExact:      3,
+	WinScale:      2,
+	WinNear:       2,
+	WinTolerance:  0.15,
+	LayoutExact:   6,
+	LayoutOverlap: 1,
+	LayoutSimilar: 2,
+}
+
+// maxField is the most a signature can earn outside of option layout
+// scoring (TTL + window + quirks), used to prune whole buckets.
+func (p *ScoringProfile) maxField() float64 {
+	return p.Quirks + p.TTL + max(p.WinAny, p.WinExact, p.WinScale, p.WinNear)
+}
+
+// Detect fingerprints the client that sent a SYN using the tcp:request
+// signatures of the default Detector.
+func Detect(m PacketMeta) Result {
+	return Default().Detect(m)
+}
+
+// DetectServer fingerprints the server that answered with a SYN+ACK using
+// the tcp:response signatures of the default Detector.
+func DetectServer(m PacketMeta) Result {
+	return Default().DetectServer(m)
+}
+
+func detect(ix *sigIndex, m PacketMeta, fuzzy bool, p *ScoringProfile) Result {
+	var cs *compiledSig
+	var score float64
+	if ix != nil {
+		cs, score = ix.match(m, fuzzy, p)
 	}
-	opts := normalizeOpts(strings.Split(strings.ToLower(strings.TrimSpace(optLayout)), ","))
-	bestClass := ""
-	bestScore := -1.0
-	for _, e := range Data.Entries {
-		if e.Section != "tcp:request" {
-			continue
-		}
-		for _, sig := range e.Sig {
-			ttlSig, winExpr, wscaleExpr, optExpr, ok := parseTcpRequestSig(sig)
-			if !ok {
-				continue
-			}
-			score := 0.0
-			if e.Label == "" {
-				continue
-			}
-			if ttlMatch(ttlSig, ttlInit) {
-				score += 3
-			}
-			if winExpr == "*" {
-				score += 3
-			} else {
-				exact := winEquals(winExpr, win, mss)
-				if exact {
-					if wscaleExpr == "" || wscaleExpr == "*" || wscale <= 0 {
-						score += 3
-					} else if wsEq(wscaleExpr, wscale) {
-						score += 3
-					} else {
-						score += 2
-					}
-				} else if nearWin(winExpr, win, mss) {
-					score += 2
-				}
-			}
-			if winExpr == "*" && mss > 0 {
-				score += 1
-			} else if strings.HasPrefix(winExpr, "mss*") && mss > 0 {
-				score += 2
-			}
-			sigOpts := normalizeOpts(strings.Split(optExpr, ","))
-			if len(sigOpts) > 0 && len(opts) > 0 {
-				actual := make(map[string]struct{}, len(opts))
-				for _, o := range opts {
-					actual[o] = struct{}{}
-				}
-				sigSet := make(map[string]struct{}, len(sigOpts))
-				for _, o := range sigOpts {
-					sigSet[o] = struct{}{}
-				}
-				sim := jaccardSimilarity(sigSet, actual)
-				if sim == 1 {
-					score += 3
-				}
-				if sim > 0.5 {
-					score += 2
-				}
-				score += sim
-			}
-			if score > bestScore {
-				bestScore = score
-				bestClass = e.Label
-			}
-		}
+	if cs == nil {
+		return Result{Label: "Unknown", Distance: guessDist(m.TTL)}
 	}
-	return bestClass
+	return newResult(cs, score, m)
 }
 
-func parseTcpRequestSig(sig string) (string, string, string, string, bool) {
-	parts := strings.Split(sig, ":")
-	if len(parts) < 5 {
-		return "", "", "", "", false
+func newResult(cs *compiledSig, score float64, m PacketMeta) Result {
+	kind := cs.kind(m)
+	return Result{
+		Label:    cs.label,
+		Generic:  cs.generic,
+		Class:    cs.class,
+		Name:     cs.name,
+		Flavor:   cs.flavor,
+		Sig:      cs.Raw,
+		Score:    score,
+		Fuzzy:    kind == MatchFuzzy,
+		Match:    kind,
+		Distance: distance(&cs.Signature, m.TTL),
 	}
-	ttlSig := strings.TrimSpace(parts[1])
-	winField := strings.TrimSpace(parts[3])
-	winExpr := winField
-	wscaleExpr := ""
-	if idx := strings.Index(winField, ","); idx >= 0 {
-		winExpr = strings.TrimSpace(winField[:idx])
-		wscaleExpr = strings.TrimSpace(winField[idx+1:])
-	}
-	optExpr := strings.TrimSpace(parts[4])
-	return ttlSig, winExpr, wscaleExpr, optExpr, true
 }
 
-func ttlMatch(ttlSig string, ttlInit int) bool {
-	if ttlSig == "*" {
-		return true
+func fieldScore(sig *Signature, m PacketMeta, p *ScoringProfile) (float64, bool) {
+	return scoreFields(sig, m, p, nil)
+}
+
+// scoreFields is fieldScore that, when why is not nil, also records the
+// points each field earned and the rule that decided them.
+func scoreFields(sig *Signature, m PacketMeta, p *ScoringProfile, why *[]Contribution) (float64, bool) {
+	win := int(m.Win)
+	mss := int(m.MSS)
+	if sig.MSS != Any && sig.MSS != mss {
+		return 0, false
 	}
-	if strings.HasSuffix(ttlSig, "-") {
-		n, err := strconv.Atoi(strings.TrimSuffix(ttlSig, "-"))
-		if err != nil {
-			return false
-		}
-		return ttlInit <= n
+	if sig.Version != Any && m.Version != 0 && sig.Version != m.Version {
+		return 0, false
+	}
+	// As in p0f, IP option length and payload class must match even for a
+	// fuzzy match.
+	if sig.OLen != m.IPOptLen || !pclassMatch(sig.PClass, m.PayloadLen) {
+		return 0, false
+	}
+	quirksExact, ok := quirksMatch(sigQuirks(sig, m.Version), m.Quirks)
+	if !ok {
+		return 0, false
+	}
+	if p.Exact && !exactMatch(sig, m) {
+		return 0, false
+	}
+	quirks := 0.0
+	if quirksExact {
+		quirks = p.Quirks
 	}
-	if strings.Contains(ttlSig, "-") {
-		parts := strings.SplitN(ttlSig, "-", 2)
-		if len(parts) != 2 {
-			return false
+	ttlOK := ttlMatch(sig, m.TTL)
+	ttl := 0.0
+	if ttlOK {
+		ttl = p.TTL
+	}
+	wr := windowRule(sig, m, p)
+	window := wr.points(p)
+	// The rules are only formatted when asked for, so that matching does
+	// not allocate.
+	if why != nil {
+		qr := Contribution{Field: "quirks", Score: quirks, Rule: "quirks equal"}
+		if !quirksExact {
+			qr.Rule = fmt.Sprintf("quirks %q tolerated for %q (df/id+ lost or id-/ecn gained)", m.Quirks, sig.Quirks)
 		}
-		lo, err1 := strconv.Atoi(parts[0])
-		hi, err2 := strconv.Atoi(parts[1])
-		if err1 != nil || err2 != nil {
-			return false
+		tr := Contribution{Field: "ttl", Score: ttl, Rule: fmt.Sprintf("ttl %d can start at %d", m.TTL, sig.TTL)}
+		if !ttlOK {
+			tr.Rule = fmt.Sprintf("ttl %d cannot start at %d", m.TTL, sig.TTL)
 		}
-		return ttlInit >= lo && ttlInit <= hi
-	}
-	n, err := strconv.Atoi(ttlSig)
-	if err != nil {
-		return false
+		*why = append(*why, qr, tr, Contribution{Field: "window", Score: window, Rule: wr.rule(win, m.WScale, p)})
 	}
-	base := 255
-	if ttlInit <= 64 {
-		base = 64
-	} else if ttlInit <= 128 {
-		base = 128
+	return quirks + ttl + window, true
+}
+
+// winRule is the rule that decided a window's points.
+type winRule int
+
+const (
+	winDiffers winRule = iota
+	winIsAny
+	winEqual
+	winAnyScale
+	winOtherScale
+	winNear
+)
+
+func windowRule(sig *Signature, m PacketMeta, p *ScoringProfile) winRule {
+	win, mss := int(m.Win), int(m.MSS)
+	switch {
+	case sig.WinType == WinAny:
+		return winIsAny
+	case winEquals(sig, win, mss, m.Version):
+		switch sig.Scale {
+		case m.WScale:
+			return winEqual
+		case Any:
+			return winAnyScale
+		}
+		return winOtherScale
+	case nearWin(sig, win, mss, m.Version, p.WinTolerance):
+		return winNear
 	}
-	return base == n || ttlInit == n
+	return winDiffers
 }
 
-func nearWin(winExpr string, win int, mss int) bool {
-	if winExpr == "" || winExpr == "*" {
-		return false
+func (r winRule) points(p *ScoringProfile) float64 {
+	switch r {
+	case winIsAny:
+		return p.WinAny
+	case winEqual, winAnyScale:
+		return p.WinExact
+	case winOtherScale:
+		return p.WinScale
+	case winNear:
+		return p.WinNear
 	}
-	if strings.HasPrefix(winExpr, "mss*") {
-		if mss <= 0 {
-			return false
-		}
-		n, err := strconv.Atoi(strings.TrimPrefix(winExpr, "mss*"))
-		if err != nil {
-			return false
-		}
-		target := mss * n
-		return withinRatio(win, target, 0.15)
+	return 0
+}
+
+func (r winRule) rule(win int, scale int, p *ScoringProfile) string {
+	switch r {
+	case winIsAny:
+		return "window is *"
+	case winEqual:
+		return fmt.Sprintf("window %d and scale %d equal", win, scale)
+	case winAnyScale:
+		return fmt.Sprintf("window %d equal, scale is *", win)
+	case winOtherScale:
+		return fmt.Sprintf("window %d equal, scale %d differs", win, scale)
+	case winNear:
+		return fmt.Sprintf("window %d within %g%%", win, p.WinTolerance*100)
 	}
-	if strings.HasPrefix(winExpr, "mtu*") {
+	return fmt.Sprintf("window %d differs", win)
+}
+
+// pclassMatch reports whether a payload of n bytes has the signature's
+// payload class: 0 for none, 1 ("+") for some, or Any.
+func pclassMatch(pclass int, n int) bool {
+	return pclass == Any || (pclass == 1) == (n > 0)
+}
+
+// maxDist is the largest hop distance p0f accepts between a signature's
+// initial TTL and the observed one.
+const maxDist = 35
+
+// ttlMatch reports whether ttl can have started at the signature's initial
+// TTL: at most maxDist hops below it, or anywhere below it for "ittl-".
+func ttlMatch(sig *Signature, ttl int) bool {
+	if ttl > sig.TTL {
 		return false
 	}
-	n, err := strconv.Atoi(winExpr)
-	if err != nil {
-		return false
+	return sig.BadTTL || sig.TTL-ttl <= maxDist
+}
+
+// distance returns the hop count from the signature's initial TTL, falling
+// back to the guessed initial TTL when the signature does not pin it.
+func distance(sig *Signature, ttl int) int {
+	if !sig.BadTTL && ttlMatch(sig, ttl) {
+		return sig.TTL - ttl
 	}
-	return withinRatio(win, n, 0.15)
+	return guessDist(ttl)
 }
 
-func winEquals(winExpr string, win int, mss int) bool {
-	if winExpr == "*" || winExpr == "" {
-		return true
+// guessTTL rounds ttl up to the nearest common initial TTL.
+func guessTTL(ttl int) int {
+	switch {
+	case ttl <= 32:
+		return 32
+	case ttl <= 64:
+		return 64
+	case ttl <= 128:
+		return 128
 	}
-	if strings.HasPrefix(winExpr, "mss*") {
-		if mss <= 0 {
-			return false
-		}
-		n, err := strconv.Atoi(strings.TrimPrefix(winExpr, "mss*"))
-		if err != nil {
-			return false
-		}
-		return win == mss*n
+	return 255
+}
+
+func guessDist(ttl int) int {
+	return guessTTL(ttl) - ttl
+}
+
+// exactMatch reports whether every field scored by fieldScore matched
+// without falling back to a near or wildcard comparison.
+func exactMatch(sig *Signature, m PacketMeta) bool {
+	if !ttlMatch(sig, m.TTL) || !winEquals(sig, int(m.Win), int(m.MSS), m.Version) {
+		return false
 	}
-	if strings.HasPrefix(winExpr, "mtu*") {
+	if sig.Scale != Any && sig.Scale != m.WScale {
 		return false
 	}
-	n, err := strconv.Atoi(winExpr)
-	if err != nil {
+	if exact, _ := quirksMatch(sigQuirks(sig, m.Version), m.Quirks); !exact {
 		return false
 	}
-	return win == n
+	return sameLayout(sig.Layout, m.Options)
 }
 
-func wsEq(sig string, wscale int) bool {
-	if sig == "" || sig == "*" {
-		return true
+// MatchKind is how a signature matched a packet. The kinds are ordered by
+// p0f's precedence: an exact match of a specific ("s:") label beats an
+// exact match of a generic ("g:") one, which beats any fuzzy match,
+// whatever their scores.
+type MatchKind int
+
+const (
+	MatchNone MatchKind = iota
+	MatchFuzzy
+	MatchGeneric
+	MatchExact
+)
+
+var matchKindNames = [...]string{"none", "fuzzy", "generic", "exact"}
+
+func (k MatchKind) String() string {
+	if k < 0 || int(k) >= len(matchKindNames) {
+		return fmt.Sprintf("MatchKind(%d)", int(k))
 	}
-	n, err := strconv.Atoi(sig)
-	if err != nil {
-		return false
+	return matchKindNames[k]
+}
+
+// MarshalText renders the kind by name in JSON output.
+func (k MatchKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+func (cs *compiledSig) kind(m PacketMeta) MatchKind {
+	switch {
+	case !exactMatch(&cs.Signature, m):
+		return MatchFuzzy
+	case cs.generic:
+		return MatchGeneric
 	}
-	return wscale == n
+	return MatchExact
 }
-func withinRatio(a int, b int, ratio float64) bool {
-	if a == 0 || b == 0 {
-		return false
+
+// outranks reports whether a signature matching with kind and score beats
+// the best one so far, ties going to the earlier signature.
+func outranks(cs *compiledSig, kind MatchKind, score float64, best *compiledSig, bestKind MatchKind, bestScore float64) bool {
+	if best == nil || kind != bestKind {
+		return kind > bestKind
 	}
-	lo := float64(b) * (1 - ratio)
-	hi := float64(b) * (1 + ratio)
-	return float64(a) >= lo && float64(a) <= hi
+	return score > bestScore || score == bestScore && cs.order < best.order
 }
 
-func normalizeOpts(opts []string) []string {
-	var out []string
-	for _, o := range opts {
-		t := strings.TrimSpace(strings.ToLower(o))
-		if t == "" {
-			continue
-		}
-		if strings.HasPrefix(t, "eol") {
-			continue
-		}
-		switch t {
-		case "mss", "ws", "sok", "ts", "nop":
-			out = append(out, t)
-		}
+// sigQuirks drops the quirks that cannot occur on the packet's IP version
+// from signatures that apply to both.
+func sigQuirks(sig *Signature, version int) Quirks {
+	if sig.Version != Any {
+		return sig.Quirks
+	}
+	switch version {
+	case 4:
+		return sig.Quirks &^ QuirkFlow
+	case 6:
+		return sig.Quirks &^ (QuirkDF | QuirkNonZeroID | QuirkZeroID)
 	}
-	return out
+	return sig.Quirks
 }
 
-func jaccardSimilarity(a map[string]struct{}, b map[string]struct{}) float64 {
-	if len(a) == 0 && len(b) == 0 {
-		return 1
+// quirksMatch follows p0f: quirk sets must be equal, except that losing
+// df or id+, or gaining id- or ecn, is tolerated as a fuzzy match.
+func quirksMatch(sig Quirks, pkt Quirks) (exact bool, ok bool) {
+	if sig == pkt {
+		return true, true
 	}
-	inter := 0
-	union := make(map[string]struct{}, len(a)+len(b))
-	for k := range a {
-		union[k] = struct{}{}
+	deleted := sig &^ pkt
+	added := pkt &^ sig
+	if deleted&^(QuirkDF|QuirkNonZeroID) != 0 || added&^(QuirkZeroID|QuirkECN) != 0 {
+		return false, false
 	}
-	for k := range b {
-		union[k] = struct{}{}
+	return false, true
+}
+
+func winTarget(sig *Signature, mss int, version int) (int, bool) {
+	switch sig.WinType {
+	case WinValue:
+		return sig.Win, true
+	case WinMSS:
+		return mss * sig.Win, mss > 0
+	case WinMTU:
+		return (mss + mtuHeader(version)) * sig.Win, mss > 0
 	}
-	for k := range a {
-		if _, ok := b[k]; ok {
-			inter++
-		}
+	return 0, false
+}
+
+func nearWin(sig *Signature, win int, mss int, version int, ratio float64) bool {
+	target, ok := winTarget(sig, mss, version)
+	if !ok {
+		return false
 	}
-	if len(union) == 0 {
-		return 0
+	return withinRatio(win, target, ratio)
+}
+
+func winEquals(sig *Signature, win int, mss int, version int) bool {
+	switch sig.WinType {
+	case WinAny:
+		return true
+	case WinMod:
+		return win%sig.Win == 0
 	}
-	return float64(inter) / float64(len(union))
+	target, ok := winTarget(sig, mss, version)
+	return ok && win == target
+}
+
+func withinRatio(a int, b int, ratio float64) bool {
+	if a == 0 || b == 0 {
+		return false
+	}
+	lo := float64(b) * (1 - ratio)
+	hi := float64(b) * (1 + ratio)
+	return float64(a) >= lo && float64(a) <= hi
 }
@@ -0,0 +1,166 @@
+package p0f
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	cases := []struct {
+		meta PacketMeta
+		want string
+	}{
+		{PacketMeta{TTL: 61, Win: 29200, MSS: 1460, WScale: 7, Options: []string{"mss", "sok", "ts", "nop", "ws"}, Quirks: QuirkDF | QuirkNonZeroID}, "s:unix:Linux:3.11 and newer"},
+		{PacketMeta{TTL: 117, Win: 8192, MSS: 1460, WScale: 8, Options: []string{"mss", "nop", "ws", "nop", "nop", "sok"}, Quirks: QuirkDF | QuirkNonZeroID}, "s:win:Windows:7 or 8"},
+		{PacketMeta{TTL: 50, Win: 65535, MSS: 1460, WScale: 6, Options: []string{"mss", "nop", "ws", "sok", "ts"}, Quirks: QuirkDF | QuirkNonZeroID}, "s:unix:FreeBSD:9.x or newer"},
+		{PacketMeta{TTL: 40, Win: 3072, MSS: 1460, Options: []string{"mss"}}, "s:!:NMap:SYN scan"},
+	}
+	for _, c := range cases {
+		if got := Detect(c.meta).Label; got != c.want {
+			t.Errorf("Detect(%+v) = %q, want %q", c.meta, got, c.want)
+		}
+	}
+}
+
+func TestDetectResult(t *testing.T) {
+	r := Detect(PacketMeta{TTL: 61, Win: 29200, MSS: 1460, WScale: 7, Options: []string{"mss", "sok", "ts", "nop", "ws"}, Quirks: QuirkDF | QuirkNonZeroID})
+	want := Result{
+		Label:    "s:unix:Linux:3.11 and newer",
+		Class:    "unix",
+		Name:     "Linux",
+		Flavor:   "3.11 and newer",
+		Sig:      "*:64:0:*:mss*20,7:mss,sok,ts,nop,ws:df,id+:0",
+		Score:    14,
+		Match:    MatchExact,
+		Distance: 3,
+	}
+	if r != want {
+		t.Fatalf("got %+v, want %+v", r, want)
+	}
+	r = Detect(PacketMeta{TTL: 118, Win: 64000, MSS: 1460, WScale: 8, Options: []string{"mss", "nop", "ws", "nop", "nop", "sok"}})
+	if !r.Fuzzy || r.Match != MatchFuzzy || r.Name != "Windows" || r.Distance != 10 {
+		t.Fatalf("unexpected fuzzy result %+v", r)
+	}
+}
+
+func TestDetectServer(t *testing.T) {
+	m := PacketMeta{TTL: 57, Win: 14600, MSS: 1460, Options: []string{"mss", "sok", "ts"}, Flags: TCPSyn | TCPAck, Ack: 1, Quirks: QuirkDF}
+	r := DetectServer(m)
+	if r.Label != "s:unix:Linux:3.x" || r.Fuzzy || r.Distance != 7 {
+		t.Fatalf("got %+v", r)
+	}
+	if c := Detect(m); c.Label == r.Label && !c.Fuzzy {
+		t.Fatalf("client signatures should not match a SYN+ACK exactly: %+v", c)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	cases := []struct {
+		sig  string
+		ttl  int
+		ok   bool
+		dist int
+	}{
+		{"4:64:0:*:*,*:mss::0", 61, true, 3},
+		{"4:64:0:*:*,*:mss::0", 29, true, 35},
+		{"4:64:0:*:*,*:mss::0", 28, false, 4},
+		{"4:64:0:*:*,*:mss::0", 65, false, 63},
+		{"4:57+7:0:*:*,*:mss::0", 57, true, 7},
+		{"4:57+?:0:*:*,*:mss::0", 50, true, 14},
+		{"4:64-:0:*:*,*:mss::0", 2, true, 30},
+		{"4:255:0:*:*,*:mss::0", 200, false, 55},
+	}
+	for _, c := range cases {
+		sig, err := ParseSignature(c.sig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok := ttlMatch(&sig, c.ttl); ok != c.ok {
+			t.Errorf("ttlMatch(%q, %d) = %v, want %v", c.sig, c.ttl, ok, c.ok)
+		}
+		if d := distance(&sig, c.ttl); d != c.dist {
+			t.Errorf("distance(%q, %d) = %d, want %d", c.sig, c.ttl, d, c.dist)
+		}
+	}
+	for ttl, want := range map[int]int{20: 12, 32: 0, 60: 4, 100: 28, 250: 5} {
+		if d := guessDist(ttl); d != want {
+			t.Errorf("guessDist(%d) = %d, want %d", ttl, d, want)
+		}
+	}
+}
+
+func TestDetectQuirks(t *testing.T) {
+	m := PacketMeta{TTL: 118, Win: 8192, MSS: 1460, WScale: 8, Options: []string{"mss", "nop", "ws", "nop", "nop", "sok"}, Quirks: QuirkDF | QuirkNonZeroID}
+	if r := Detect(m); r.Fuzzy {
+		t.Fatalf("expected exact match, got %+v", r)
+	}
+	m.Quirks = QuirkZeroID | QuirkECN
+	if r := Detect(m); !r.Fuzzy || r.Name != "Windows" {
+		t.Fatalf("expected fuzzy Windows match, got %+v", r)
+	}
+	m.Quirks = QuirkDF | QuirkNonZeroID | QuirkNonZeroAck
+	if r := Detect(m); r.Name == "Windows" {
+		t.Fatalf("ack+ should rule out Windows signatures, got %+v", r)
+	}
+}
+
+func TestDetectLayoutOrder(t *testing.T) {
+	mac := PacketMeta{TTL: 52, Win: 65535, MSS: 1460, WScale: 4, Options: []string{"mss", "nop", "ws", "nop", "nop", "ts", "sok", "eol+1"}, Quirks: QuirkDF | QuirkNonZeroID}
+	if r := Detect(mac); r.Label != "s:unix:MacOS X:10.9 or newer (sometimes iPhone or iPad)" {
+		t.Fatalf("got %+v", r)
+	}
+	bsd := mac
+	bsd.Options = []string{"mss", "nop", "ws", "sok", "ts"}
+	if r := Detect(bsd); r.Name != "FreeBSD" {
+		t.Fatalf("got %+v", r)
+	}
+	odd := mac
+	odd.Options = []string{"mss", "ws", "nop", "sok", "ts"}
+	if r := Detect(odd); r.Label == "Unknown" || !r.Fuzzy {
+		t.Fatalf("expected fuzzy layout fallback, got %+v", r)
+	}
+	d := NewDetector(&Data, Options{})
+	if r := d.Detect(odd); r.Label != "Unknown" {
+		t.Fatalf("expected no match without fuzzy layouts, got %+v", r)
+	}
+}
+
+func TestDetectOLenPClass(t *testing.T) {
+	db, err := LoadDB(strings.NewReader("classes = unix\n[tcp:request]\nlabel = s:unix:TFO:1\nsig = *:64:0:*:*,*:mss:df:+\nlabel = s:unix:Opts:1\nsig = *:64:4:*:*,*:mss:df:*\nlabel = s:unix:Plain:1\nsig = *:64:0:*:*,*:mss:df:0\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDetector(db, DefaultOptions())
+	m := PacketMeta{Version: 4, TTL: 60, Win: 1024, MSS: 1460, Options: []string{"mss"}, Quirks: QuirkDF}
+	for _, c := range []struct {
+		olen, payload int
+		want          string
+	}{
+		{0, 0, "s:unix:Plain:1"},
+		{0, 100, "s:unix:TFO:1"},
+		{4, 0, "s:unix:Opts:1"},
+		{4, 100, "s:unix:Opts:1"},
+		{8, 0, "Unknown"},
+	} {
+		m.IPOptLen, m.PayloadLen = c.olen, c.payload
+		if r := d.Detect(m); r.Label != c.want {
+			t.Errorf("olen %d payload %d: got %+v, want %s", c.olen, c.payload, r, c.want)
+		}
+	}
+}
+
+func TestDetectDoesNotAllocate(t *testing.T) {
+	linux := PacketMeta{Version: 4, TTL: 61, Win: 29200, MSS: 1460, WScale: 7, Options: []string{"mss", "sok", "ts", "nop", "ws"}, Quirks: QuirkDF | QuirkNonZeroID}
+	fuzzy := linux
+	fuzzy.Options = []string{"mss", "nop", "ws", "sok", "ts"}
+	fuzzy.Quirks = QuirkNonZeroID
+	for _, m := range []PacketMeta{linux, fuzzy} {
+		if n := testing.AllocsPerRun(100, func() { Detect(m) }); n != 0 {
+			t.Errorf("Detect(%v) allocates %v times", m.Options, n)
+		}
+		// Only the returned slice.
+		if n := testing.AllocsPerRun(100, func() { DetectTopN(m, 3) }); n != 1 {
+			t.Errorf("DetectTopN(%v) allocates %v times", m.Options, n)
+		}
+	}
+}
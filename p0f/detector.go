@@ -0,0 +1,173 @@
+package p0f
+
+import (
+	"strings"
+	"sync/atomic"
+)
+
+// Options configures a Detector.
+type Options struct {
+	// Profile weighs the fields compared by the tcp matcher; nil means
+	// DefaultProfile.
+	Profile *ScoringProfile
+	// Fuzzy enables the fallback to signatures with a different option
+	// layout when no signature with the packet's exact layout matches.
+	Fuzzy bool
+	// Sections lists the p0f.fp sections to match against; nil enables
+	// all of them. A disabled section reports "Unknown" ("" for mtu).
+	Sections []string
+}
+
+// DefaultOptions returns the options of the default Detector.
+func DefaultOptions() Options {
+	return Options{Fuzzy: true}
+}
+
+// Detector matches packets against one database. It is immutable once
+// built and safe for concurrent use.
+type Detector struct {
+	c       *compiledDB
+	opts    Options
+	profile ScoringProfile
+}
+
+// NewDetector compiles db with the given options.
+func NewDetector(db *DB, opts Options) *Detector {
+	d := &Detector{opts: opts, profile: DefaultProfile}
+	if opts.Profile != nil {
+		d.profile = *opts.Profile
+	}
+	d.opts.Profile = &d.profile
+	if opts.Sections != nil {
+		d.opts.Sections = append([]string{}, opts.Sections...)
+	}
+	d.c = compileDB(db, d.enabled)
+	return d
+}
+
+func (d *Detector) enabled(section string) bool {
+	return d.opts.Sections == nil || contains(d.opts.Sections, section)
+}
+
+// DB returns the database the detector was built from.
+func (d *Detector) DB() *DB { return d.c.db }
+
+// Options returns a copy of the options the detector was built with.
+func (d *Detector) Options() Options {
+	opts := d.opts
+	p := d.profile
+	opts.Profile = &p
+	return opts
+}
+
+// Detect fingerprints the client that sent a SYN using the tcp:request
+// signatures.
+func (d *Detector) Detect(m PacketMeta) Result {
+	return detect(d.c.tcpRequest, m, d.opts.Fuzzy, &d.profile)
+}
+
+// DetectServer fingerprints the server that answered with a SYN+ACK using
+// the tcp:response signatures.
+func (d *Detector) DetectServer(m PacketMeta) Result {
+	return detect(d.c.tcpResponse, m, d.opts.Fuzzy, &d.profile)
+}
+
+// DetectHTTP fingerprints the client that sent an HTTP request using the
+// http:request signatures.
+func (d *Detector) DetectHTTP(h HTTPMeta) HTTPResult {
+	ua, _ := h.Header("User-Agent")
+	r := matchHTTP(d.c.httpRequest, h, ua)
+	r.UA = ua
+	r.UAOS = d.UAOS(ua)
+	return r
+}
+
+// DetectHTTPServer fingerprints the server that sent an HTTP response using
+// the http:response signatures; Dishonest flags a Server banner that does
+// not name the software the header layout belongs to.
+func (d *Detector) DetectHTTPServer(h HTTPMeta) HTTPResult {
+	server, _ := h.Header("Server")
+	r := matchHTTP(d.c.httpResponse, h, server)
+	r.UA = server
+	return r
+}
+
+// DetectLink labels the link a SYN came through from the MSS it advertised.
+// It returns "" when the packet carries no MSS or the mtu section is
+// disabled, and "Unknown" when the MTU is not listed.
+func (d *Detector) DetectLink(mss uint16, version int) string {
+	mtu := MTU(mss, version)
+	if mtu == 0 || d.c.mtu == nil {
+		return ""
+	}
+	if l, ok := d.c.mtu[mtu]; ok {
+		return l
+	}
+	return "Unknown"
+}
+
+// UAOS returns the operating system a User-Agent string claims according to
+// the ua_os directive, or "".
+func (d *Detector) UAOS(ua string) string {
+	for _, u := range d.c.db.UAOS {
+		if strings.Contains(ua, u.Match) {
+			return u.OS
+		}
+	}
+	return ""
+}
+
+// compiledDB holds the matchers built from one DB; disabled sections are
+// left nil.
+type compiledDB struct {
+	db           *DB
+	tcpRequest   *sigIndex
+	tcpResponse  *sigIndex
+	httpRequest  []compiledHTTPSig
+	httpResponse []compiledHTTPSig
+	mtu          map[int]string
+}
+
+func compileDB(db *DB, enabled func(string) bool) *compiledDB {
+	c := &compiledDB{db: db}
+	if enabled("tcp:request") {
+		c.tcpRequest = compileIndex(db, "tcp:request")
+	}
+	if enabled("tcp:response") {
+		c.tcpResponse = compileIndex(db, "tcp:response")
+	}
+	if enabled("http:request") {
+		c.httpRequest = compileHTTP(db, "http:request")
+	}
+	if enabled("http:response") {
+		c.httpResponse = compileHTTP(db, "http:response")
+	}
+	if enabled("mtu") {
+		c.mtu = compileMTU(db)
+	}
+	return c
+}
+
+var defaultDetector atomic.Pointer[Detector]
+
+// Default returns the Detector behind the package-level functions, built
+// from the embedded Data on first use.
+func Default() *Detector {
+	if d := defaultDetector.Load(); d != nil {
+		return d
+	}
+	defaultDetector.CompareAndSwap(nil, NewDetector(&Data, DefaultOptions()))
+	return defaultDetector.Load()
+}
+
+// SetDefault makes d the Detector behind Detect, DetectServer, DetectHTTP,
+// DetectHTTPServer, DetectLink and UAOS.
+func SetDefault(d *Detector) {
+	defaultDetector.Store(d)
+}
+
+// SetDB replaces the default Detector with one built from db, keeping its
+// options.
+func SetDB(db *DB) {
+	SetDefault(NewDetector(db, Default().Options()))
+}
@@ -0,0 +1,77 @@
+package p0f
+
+import (
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestDetectorsSideBySide(t *testing.T) {
+	db, err := LoadDB(strings.NewReader("classes = unix\n[tcp:request]\nlabel = s:unix:Custom:1\nsig = *:64:0:*:*,*:mss::0\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	custom := NewDetector(db, DefaultOptions())
+	stock := NewDetector(&Data, DefaultOptions())
+	linux := PacketMeta{TTL: 61, Win: 29200, MSS: 1460, WScale: 7, Options: []string{"mss", "sok", "ts", "nop", "ws"}, Quirks: QuirkDF | QuirkNonZeroID}
+	if r := stock.Detect(linux); r.Name != "Linux" {
+		t.Fatalf("stock = %+v", r)
+	}
+	if r := custom.Detect(PacketMeta{TTL: 60, Win: 1, MSS: 1460, Options: []string{"mss"}}); r.Name != "Custom" {
+		t.Fatalf("custom = %+v", r)
+	}
+	if r := custom.DetectServer(linux); r.Label != "Unknown" {
+		t.Fatalf("custom server = %+v", r)
+	}
+	if l := custom.DetectLink(1460, 4); l != "Unknown" {
+		t.Fatalf("custom link = %q", l)
+	}
+}
+
+func TestDetectorOptions(t *testing.T) {
+	linux := PacketMeta{TTL: 61, Win: 29200, MSS: 1460, WScale: 7, Options: []string{"mss", "sok", "ts", "nop", "ws"}, Quirks: QuirkDF | QuirkNonZeroID}
+	d := NewDetector(&Data, Options{Fuzzy: true, Sections: []string{"tcp:request"}})
+	if r := d.Detect(linux); r.Name != "Linux" {
+		t.Fatalf("Detect = %+v", r)
+	}
+	if l := d.DetectLink(1460, 4); l != "" {
+		t.Fatalf("DetectLink with mtu disabled = %q", l)
+	}
+	if r := d.DetectHTTP(HTTPMeta{Version: 1, Headers: []HTTPHeader{{Name: "Host", Value: "x"}}}); r.Label != "Unknown" {
+		t.Fatalf("DetectHTTP with http:request disabled = %+v", r)
+	}
+
+	p := DefaultProfile
+	p.TTL = 0
+	d = NewDetector(&Data, Options{Profile: &p, Fuzzy: true})
+	want := Detect(linux).Score - DefaultProfile.TTL
+	if r := d.Detect(linux); r.Score != want {
+		t.Fatalf("score with TTL weight 0 = %v, want %v", r.Score, want)
+	}
+	p.TTL = 100
+	if r := d.Detect(linux); r.Score != want {
+		t.Fatalf("profile not copied: score = %v", r.Score)
+	}
+}
+
+func TestDetectorConcurrent(t *testing.T) {
+	defer SetDB(&Data)
+	m := PacketMeta{TTL: 61, Win: 29200, MSS: 1460, WScale: 7, Options: []string{"mss", "sok", "ts", "nop", "ws"}, Quirks: QuirkDF | QuirkNonZeroID}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				if r := Detect(m); r.Name != "Linux" {
+					t.Errorf("Detect = %+v", r)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 20; i++ {
+		SetDB(&Data)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,145 @@
+package p0f
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// explainTop is the number of candidates Explain reports.
+const explainTop = 5
+
+// Contribution is the points one field of a signature earned against a
+// packet and the rule that decided them.
+type Contribution struct {
+	Field string  `json:"field"`
+	Score float64 `json:"score"`
+	Rule  string  `json:"rule"`
+}
+
+// Candidate is one signature the matcher scored, with the breakdown of its
+// score.
+type Candidate struct {
+	Label  string         `json:"label"`
+	Sig    string         `json:"sig"`
+	Score  float64        `json:"score"`
+	Fuzzy  bool           `json:"fuzzy"`
+	Match  MatchKind      `json:"match"`
+	Fields []Contribution `json:"fields"`
+}
+
+// Explain returns the best tcp:request candidates for a SYN in the order
+// the default Detector ranks them; the first one is what Detect reports.
+func Explain(m PacketMeta) []Candidate {
+	return Default().Explain(m)
+}
+
+// ExplainServer is Explain for a SYN+ACK and the tcp:response signatures.
+func ExplainServer(m PacketMeta) []Candidate {
+	return Default().ExplainServer(m)
+}
+
+// Explain returns the best tcp:request candidates for a SYN in the order
+// the detector ranks them; the first one is what Detect reports.
+func (d *Detector) Explain(m PacketMeta) []Candidate {
+	return explain(d.c.tcpRequest, m, d.opts.Fuzzy, &d.profile, explainTop)
+}
+
+// ExplainServer is Explain for a SYN+ACK and the tcp:response signatures.
+func (d *Detector) ExplainServer(m PacketMeta) []Candidate {
+	return explain(d.c.tcpResponse, m, d.opts.Fuzzy, &d.profile, explainTop)
+}
+
+// ranked is one acceptable signature with its score and, when asked for,
+// the breakdown of that score.
+type ranked struct {
+	cs     *compiledSig
+	kind   MatchKind
+	score  float64
+	fields []Contribution
+}
+
+// before reports whether r ranks ahead of o.
+func (r ranked) before(o ranked) bool {
+	return outranks(r.cs, r.kind, r.score, o.cs, o.kind, o.score)
+}
+
+// rank scores every signature without the pruning of sigIndex.match and
+// returns the acceptable ones best first by MatchKind and then score, ties
+// going to the earlier one.
+// As in match, other layouts are only considered when no signature with
+// the packet's exact layout is acceptable.
+func rank(ix *sigIndex, m PacketMeta, fuzzy bool, p *ScoringProfile, why bool) []ranked {
+	if ix == nil {
+		return nil
+	}
+	var all []ranked
+	mask := optMask(m.Options)
+	for _, exactPass := range []bool{true, false} {
+		if !exactPass && (len(all) > 0 || !fuzzy || p.Exact) {
+			break
+		}
+		for bi := range ix.buckets {
+			b := &ix.buckets[bi]
+			if sameLayout(b.layout, m.Options) != exactPass {
+				continue
+			}
+			layout := Contribution{Field: "layout", Score: p.LayoutExact, Rule: "option layout equal"}
+			if !exactPass {
+				layout.Score = fuzzyLayoutScore(b.mask, mask, p)
+				if why {
+					layout.Rule = fmt.Sprintf("option layout differs, option set similarity %.2f", layoutSimilarity(b.mask, mask))
+				}
+			}
+			for i := range b.sigs {
+				cs := &b.sigs[i]
+				var fields *[]Contribution
+				if why {
+					fields = &[]Contribution{layout}
+				}
+				fs, ok := scoreFields(&cs.Signature, m, p, fields)
+				if !ok {
+					continue
+				}
+				r := ranked{cs: cs, kind: cs.kind(m), score: layout.Score + fs}
+				if why {
+					r.fields = *fields
+				}
+				all = append(all, r)
+			}
+		}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].before(all[j]) })
+	return all
+}
+
+func explain(ix *sigIndex, m PacketMeta, fuzzy bool, p *ScoringProfile, n int) []Candidate {
+	all := rank(ix, m, fuzzy, p, true)
+	if len(all) > n {
+		all = all[:n]
+	}
+	out := make([]Candidate, len(all))
+	for i, r := range all {
+		out[i] = Candidate{
+			Label:  r.cs.label,
+			Sig:    r.cs.Raw,
+			Score:  r.score,
+			Fuzzy:  r.kind == MatchFuzzy,
+			Match:  r.kind,
+			Fields: r.fields,
+		}
+	}
+	return out
+}
+
+// WriteExplain writes candidates as text for a terminal: a line per
+// candidate with its rank, score, match kind, label and signature, then an
+// indented line per field.
+func WriteExplain(w io.Writer, cands []Candidate) {
+	for i, c := range cands {
+		fmt.Fprintf(w, "  #%d %.2f %-7s %s [%s]\n", i+1, c.Score, c.Match, c.Label, c.Sig)
+		for _, f := range c.Fields {
+			fmt.Fprintf(w, "     %-6s %+.2f %s\n", f.Field, f.Score, f.Rule)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package p0f
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	m := PacketMeta{TTL: 61, Win: 29200, MSS: 1460, WScale: 7, Options: []string{"mss", "sok", "ts", "nop", "ws"}, Quirks: QuirkDF | QuirkNonZeroID}
+	cands := Explain(m)
+	if len(cands) != explainTop {
+		t.Fatalf("got %d candidates", len(cands))
+	}
+	want := map[string]float64{"layout": 6, "quirks": 2, "ttl": 3, "window": 3}
+	for _, f := range cands[0].Fields {
+		if want[f.Field] != f.Score || f.Rule == "" {
+			t.Fatalf("field %+v", f)
+		}
+		delete(want, f.Field)
+	}
+	if len(want) != 0 {
+		t.Fatalf("missing fields %v", want)
+	}
+	for i := 1; i < len(cands); i++ {
+		if cands[i].Score > cands[i-1].Score {
+			t.Fatalf("candidates out of order: %+v", cands)
+		}
+	}
+
+	names := []string{"mss", "ws", "sok", "ts", "nop", "sack", "eol+1"}
+	rng := rand.New(rand.NewSource(2))
+	for i := 0; i < 2000; i++ {
+		m := PacketMeta{
+			TTL:    []int{50, 64, 100, 128, 255}[rng.Intn(5)],
+			Win:    []uint16{8192, 29200, 64240, 65535}[rng.Intn(4)],
+			MSS:    []uint16{0, 1360, 1460}[rng.Intn(3)],
+			WScale: rng.Intn(9),
+			Quirks: []Quirks{0, QuirkDF | QuirkNonZeroID, QuirkNonZeroID}[rng.Intn(3)],
+		}
+		for j := rng.Intn(6); j > 0; j-- {
+			m.Options = append(m.Options, names[rng.Intn(len(names))])
+		}
+		r := Detect(m)
+		cands := Explain(m)
+		if len(cands) == 0 {
+			if r.Label != "Unknown" {
+				t.Fatalf("%+v: no candidates for %+v", m, r)
+			}
+			continue
+		}
+		c := cands[0]
+		if c.Label != r.Label || c.Sig != r.Sig || c.Score != r.Score || c.Fuzzy != r.Fuzzy {
+			t.Fatalf("%+v: Explain picked %+v, Detect %+v", m, c, r)
+		}
+		sum := 0.0
+		for _, f := range c.Fields {
+			sum += f.Score
+		}
+		if math.Abs(sum-c.Score) > 1e-9 {
+			t.Fatalf("%+v: fields sum to %v, score %v", m, sum, c.Score)
+		}
+	}
+}
+
+func TestExplainWildcardScale(t *testing.T) {
+	db, err := LoadDB(strings.NewReader("classes = win\n[tcp:request]\nlabel = s:win:Windows:XP\nsig = *:128:0:*:16384,*:mss,nop,nop,sok:df,id+:0\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDetector(db, DefaultOptions())
+	m := PacketMeta{TTL: 120, Win: 16384, MSS: 1460, WScale: 3, Options: []string{"mss", "nop", "nop", "sok"}, Quirks: QuirkDF | QuirkNonZeroID}
+	c := d.Explain(m)
+	if len(c) != 1 || c[0].Match != MatchExact {
+		t.Fatalf("got %+v", c)
+	}
+	if w := c[0].Fields[3]; w.Score != DefaultProfile.WinExact || w.Rule != "window 16384 equal, scale is *" {
+		t.Fatalf("window %+v", w)
+	}
+}
+
+func TestWriteExplain(t *testing.T) {
+	var b strings.Builder
+	WriteExplain(&b, []Candidate{{Label: "s:unix:Linux:3.11 and newer", Sig: "*:64:0:*:mss*20,7:mss:df:0", Score: 14, Match: MatchExact, Fields: []Contribution{{Field: "ttl", Score: 3, Rule: "ttl 61 can start at 64"}}}})
+	want := "  #1 14.00 exact   s:unix:Linux:3.11 and newer [*:64:0:*:mss*20,7:mss:df:0]\n     ttl    +3.00 ttl 61 can start at 64\n"
+	if b.String() != want {
+		t.Fatalf("got %q, want %q", b.String(), want)
+	}
+}
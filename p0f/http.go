@@ -0,0 +1,445 @@
+package p0f
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// HTTPHeader is a header as it appears in a request or response.
+type HTTPHeader struct {
+	Name  string
+	Value string
+}
+
+// HTTPMeta is the header block of an HTTP/1.x request or response.
+type HTTPMeta struct {
+	Response bool
+	Version  int
+	Method   string
+	Path     string
+	Status   int
+	Headers  []HTTPHeader
+}
+
+// Header returns the value of the first header called name.
+func (h *HTTPMeta) Header(name string) (string, bool) {
+	for _, hd := range h.Headers {
+		if strings.EqualFold(hd.Name, name) {
+			return hd.Value, true
+		}
+	}
+	return "", false
+}
+
+// HTTPSigHeader is one entry of a signature's header order.
+type HTTPSigHeader struct {
+	Name     string
+	Value    string
+	HasValue bool
+	Optional bool
+}
+
+// HTTPSignature is a compiled http:request or http:response signature laid
+// out as ver:horder:habsent:expsw.
+type HTTPSignature struct {
+	Raw      string
+	Version  int
+	Order    []HTTPSigHeader
+	Absent   []string
+	Software string
+}
+
+func ParseHTTPSignature(s string) (HTTPSignature, error) {
+	sig := HTTPSignature{Raw: s}
+	ver, rest, ok := strings.Cut(s, ":")
+	if !ok {
+		return sig, fmt.Errorf("missing header order")
+	}
+	switch ver {
+	case "0":
+		sig.Version = 0
+	case "1":
+		sig.Version = 1
+	case "*":
+		sig.Version = Any
+	default:
+		return sig, fmt.Errorf("bad http version %q", ver)
+	}
+	horder, rest, ok := cutUnbracketed(rest, ':')
+	if !ok {
+		return sig, fmt.Errorf("missing absent headers")
+	}
+	absent, sw, ok := strings.Cut(rest, ":")
+	if !ok {
+		return sig, fmt.Errorf("missing expected software")
+	}
+	for horder != "" {
+		var item string
+		item, horder, _ = cutUnbracketed(horder, ',')
+		h, err := parseHTTPSigHeader(item)
+		if err != nil {
+			return sig, err
+		}
+		sig.Order = append(sig.Order, h)
+	}
+	if absent != "" {
+		for _, a := range strings.Split(absent, ",") {
+			if a == "" {
+				return sig, fmt.Errorf("empty absent header")
+			}
+			sig.Absent = append(sig.Absent, a)
+		}
+	}
+	sig.Software = sw
+	return sig, nil
+}
+
+func parseHTTPSigHeader(s string) (HTTPSigHeader, error) {
+	var h HTTPSigHeader
+	if name, ok := strings.CutPrefix(s, "?"); ok {
+		h.Optional = true
+		s = name
+	}
+	if name, val, ok := strings.Cut(s, "="); ok {
+		if !strings.HasPrefix(val, "[") || !strings.HasSuffix(val, "]") {
+			return h, fmt.Errorf("bad header value %q", s)
+		}
+		h.Value = val[1 : len(val)-1]
+		h.HasValue = true
+		s = name
+	}
+	if s == "" {
+		return h, fmt.Errorf("empty header name")
+	}
+	h.Name = s
+	return h, nil
+}
+
+// cutUnbracketed is strings.Cut that ignores sep inside [...] values.
+func cutUnbracketed(s string, sep byte) (before, after string, found bool) {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '[':
+			depth++
+		case ']':
+			if depth > 0 {
+				depth--
+			}
+		case sep:
+			if depth == 0 {
+				return s[:i], s[i+1:], true
+			}
+		}
+	}
+	return s, "", false
+}
+
+// HTTPResult is the outcome of matching an HTTP header block.
+type HTTPResult struct {
+	Label   string
+	Generic bool
+	Class   string
+	Name    string
+	Flavor  string
+	Sig     string
+	// Sys lists the operating systems the matched software runs on.
+	Sys []string
+	// UA is the User-Agent (requests) or Server (responses) header.
+	UA string
+	// UAOS is the operating system the User-Agent claims, from ua_os.
+	UAOS string
+	// Dishonest is set when the header layout belongs to software that
+	// the User-Agent does not name.
+	Dishonest bool
+}
+
+// OSMismatch reports whether the OS claimed by the User-Agent differs from
+// the one fingerprinted from the same host's SYN, comparing OS families.
+func (r HTTPResult) OSMismatch(tcp Result) bool {
+	return r.UAOS != "" && tcp.Label != "Unknown" && tcp.Name != "" && osFamily(r.UAOS) != osFamily(tcp.Name)
+}
+
+// osFamily folds the OS names of p0f.fp into the families a TCP stack can
+// tell apart: the tcp sections spell macOS both "Mac OS X" and "MacOS X",
+// and Macs, iPhones and iPads share one stack, so "iOS" from ua_os and any
+// of the Apple tcp labels are the same family.
+func osFamily(name string) string {
+	n := strings.ToLower(strings.ReplaceAll(name, " ", ""))
+	switch n {
+	case "macosx", "ios":
+		return "apple"
+	}
+	return n
+}
+
+// UAOS returns the operating system a User-Agent string claims according to
+// the ua_os directive, or "".
+func UAOS(ua string) string {
+	return Default().UAOS(ua)
+}
+
+type compiledHTTPSig struct {
+	HTTPSignature
+	sys     []string
+	label   string
+	generic bool
+	class   string
+	name    string
+	flavor  string
+}
+
+func compileHTTP(db *DB, section string) []compiledHTTPSig {
+	var out []compiledHTTPSig
+	for _, e := range sectionEntries(db, section) {
+		generic, class, name, flavor := e.labelParts()
+		for _, sig := range e.httpSigs() {
+			out = append(out, compiledHTTPSig{HTTPSignature: sig, sys: e.Sys, label: e.Label, generic: generic, class: class, name: name, flavor: flavor})
+		}
+	}
+	return out
+}
+
+// DetectHTTP fingerprints the client that sent an HTTP request using the
+// http:request signatures of the default Detector.
+func DetectHTTP(h HTTPMeta) HTTPResult {
+	return Default().DetectHTTP(h)
+}
+
+// DetectHTTPServer fingerprints the server that sent an HTTP response using
+// the http:response signatures; Dishonest flags a Server banner that does
+// not name the software the header layout belongs to.
+func DetectHTTPServer(h HTTPMeta) HTTPResult {
+	return Default().DetectHTTPServer(h)
+}
+
+// matchHTTP returns the first signature whose layout matches and whose
+// expected software appears in sw; failing that, the first layout match is
+// reported as dishonest.
+func matchHTTP(sigs []compiledHTTPSig, h HTTPMeta, sw string) HTTPResult {
+	var dishonest *compiledHTTPSig
+	for i := range sigs {
+		cs := &sigs[i]
+		if !httpLayoutMatch(&cs.HTTPSignature, h) {
+			continue
+		}
+		if cs.Software == "" || sw == "" || strings.Contains(sw, cs.Software) {
+			return httpResult(cs, false)
+		}
+		if dishonest == nil {
+			dishonest = cs
+		}
+	}
+	if dishonest != nil {
+		return httpResult(dishonest, true)
+	}
+	return HTTPResult{Label: "Unknown"}
+}
+
+func httpResult(cs *compiledHTTPSig, dishonest bool) HTTPResult {
+	return HTTPResult{
+		Label:     cs.label,
+		Generic:   cs.generic,
+		Class:     cs.class,
+		Name:      cs.name,
+		Flavor:    cs.flavor,
+		Sig:       cs.Raw,
+		Sys:       cs.sys,
+		Dishonest: dishonest,
+	}
+}
+
+// httpLayoutMatch checks that the signature's headers appear in order with
+// the listed values as substrings, optional ones possibly missing, and that
+// none of the absent headers is present. Other headers are ignored.
+func httpLayoutMatch(sig *HTTPSignature, h HTTPMeta) bool {
+	if sig.Version != Any && sig.Version != h.Version {
+		return false
+	}
+	p := 0
+	for _, want := range sig.Order {
+		i := p
+		for i < len(h.Headers) && !strings.EqualFold(h.Headers[i].Name, want.Name) {
+			i++
+		}
+		if i == len(h.Headers) {
+			if !want.Optional {
+				return false
+			}
+			continue
+		}
+		if want.HasValue && !strings.Contains(h.Headers[i].Value, want.Value) {
+			return false
+		}
+		p = i + 1
+	}
+	for _, a := range sig.Absent {
+		if _, ok := h.Header(a); ok {
+			return false
+		}
+	}
+	return true
+}
+
+// maxHTTPHeader bounds the header block buffered per flow.
+const maxHTTPHeader = 8192
+
+// ParseHTTP decodes a complete HTTP/1.x request or response header block. It
+// reports false when b does not start with one or the block is incomplete.
+func ParseHTTP(b []byte) (HTTPMeta, bool) {
+	var h HTTPMeta
+	end := bytes.Index(b, []byte("\r\n\r\n"))
+	if end < 0 {
+		return h, false
+	}
+	lines := strings.Split(string(b[:end]), "\r\n")
+	if !parseStartLine(lines[0], &h) {
+		return h, false
+	}
+	for _, l := range lines[1:] {
+		if l == "" {
+			return h, false
+		}
+		if l[0] == ' ' || l[0] == '\t' {
+			if len(h.Headers) == 0 {
+				return h, false
+			}
+			last := &h.Headers[len(h.Headers)-1]
+			last.Value += " " + strings.TrimSpace(l)
+			continue
+		}
+		name, val, ok := strings.Cut(l, ":")
+		if !ok || name == "" || strings.ContainsAny(name, " \t") {
+			return h, false
+		}
+		h.Headers = append(h.Headers, HTTPHeader{Name: name, Value: strings.TrimSpace(val)})
+	}
+	return h, true
+}
+
+func parseStartLine(l string, h *HTTPMeta) bool {
+	f := strings.SplitN(l, " ", 3)
+	if len(f) == 2 {
+		// a status line may omit the reason phrase
+		f = append(f, "")
+	}
+	if len(f) != 3 {
+		return false
+	}
+	if ver, ok := httpVersion(f[0]); ok {
+		status, err := strconv.Atoi(f[1])
+		if err != nil || len(f[1]) != 3 {
+			return false
+		}
+		h.Response, h.Version, h.Status = true, ver, status
+		return true
+	}
+	ver, ok := httpVersion(f[2])
+	if !ok || !isHTTPMethod(f[0]) || f[1] == "" {
+		return false
+	}
+	h.Method, h.Path, h.Version = f[0], f[1], ver
+	return true
+}
+
+func httpVersion(s string) (int, bool) {
+	switch s {
+	case "HTTP/1.0":
+		return 0, true
+	case "HTTP/1.1":
+		return 1, true
+	}
+	return 0, false
+}
+
+var httpMethods = []string{"GET", "POST", "HEAD", "PUT", "DELETE", "OPTIONS", "PATCH", "CONNECT", "TRACE"}
+
+func isHTTPMethod(s string) bool {
+	for _, m := range httpMethods {
+		if s == m {
+			return true
+		}
+	}
+	return false
+}
+
+// looksLikeHTTP reports whether a flow's first payload starts an HTTP/1.x
+// request or response.
+func looksLikeHTTP(b []byte) bool {
+	if bytes.HasPrefix(b, []byte("HTTP/1.")) {
+		return true
+	}
+	for _, m := range httpMethods {
+		if len(b) > len(m) && string(b[:len(m)]) == m && b[len(m)] == ' ' {
+			return true
+		}
+	}
+	return false
+}
+
+type flowKey struct {
+	src, dst     [16]byte
+	sport, dport int
+}
+
+type httpFlow struct {
+	buf  []byte
+	done bool
+}
+
+// HTTPTracker collects the header block of the first HTTP/1.x request or
+// response sent in each direction of a TCP flow. Packets must be fed in
+// order; it is not safe for concurrent use.
+type HTTPTracker struct {
+	flows map[flowKey]*httpFlow
+	max   int
+}
+
+// NewHTTPTracker returns a tracker that follows at most maxFlows flows,
+// evicting arbitrary ones beyond that.
+func NewHTTPTracker(maxFlows int) *HTTPTracker {
+	return &HTTPTracker{flows: make(map[flowKey]*httpFlow), max: maxFlows}
+}
+
+// Feed adds a packet's payload to its flow and returns the header block once
+// the first message on the flow is complete.
+func (t *HTTPTracker) Feed(p Packet) (HTTPMeta, bool) {
+	var k flowKey
+	copy(k.src[:], p.SrcIP.To16())
+	copy(k.dst[:], p.DstIP.To16())
+	k.sport, k.dport = p.SrcPort, p.DstPort
+	if p.Meta.Flags&(TCPFin|TCPRst) != 0 {
+		delete(t.flows, k)
+		return HTTPMeta{}, false
+	}
+	if len(p.Payload) == 0 {
+		return HTTPMeta{}, false
+	}
+	f := t.flows[k]
+	if f == nil {
+		if len(t.flows) >= t.max {
+			for old := range t.flows {
+				delete(t.flows, old)
+				break
+			}
+		}
+		f = &httpFlow{done: !looksLikeHTTP(p.Payload)}
+		t.flows[k] = f
+	}
+	if f.done {
+		return HTTPMeta{}, false
+	}
+	n := len(p.Payload)
+	if room := maxHTTPHeader - len(f.buf); n > room {
+		n = room
+	}
+	f.buf = append(f.buf, p.Payload[:n]...)
+	h, ok := ParseHTTP(f.buf)
+	if ok || len(f.buf) >= maxHTTPHeader || bytes.Contains(f.buf, []byte("\r\n\r\n")) {
+		f.done = true
+		f.buf = nil
+	}
+	return h, ok
+}
@@ -0,0 +1,166 @@
+package p0f
+
+import (
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestParseHTTPSignature(t *testing.T) {
+	sig, err := ParseHTTPSignature("1:Host,Connection=[keep-alive],?Referer,Accept=[*/*;q=0.8]:Accept-Charset,Keep-Alive: Chrom")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig.Version != 1 || len(sig.Order) != 4 || sig.Software != " Chrom" {
+		t.Fatalf("bad fields: %+v", sig)
+	}
+	if h := sig.Order[1]; h.Name != "Connection" || !h.HasValue || h.Value != "keep-alive" || h.Optional {
+		t.Fatalf("bad header %+v", h)
+	}
+	if h := sig.Order[2]; h.Name != "Referer" || h.HasValue || !h.Optional {
+		t.Fatalf("bad optional header %+v", h)
+	}
+	if len(sig.Absent) != 2 || sig.Absent[1] != "Keep-Alive" {
+		t.Fatalf("bad absent headers %v", sig.Absent)
+	}
+	for _, bad := range []string{"2:Host::x", "1:Host", "1:Host=keep::x", "1:,Host::x"} {
+		if _, err := ParseHTTPSignature(bad); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestDataHTTPSignaturesParse(t *testing.T) {
+	for _, e := range Data.Entries {
+		if e.Section != "http:request" && e.Section != "http:response" {
+			continue
+		}
+		for _, s := range e.Sig {
+			if _, err := ParseHTTPSignature(s); err != nil {
+				t.Errorf("%s %q: %v", e.Label, s, err)
+			}
+		}
+	}
+}
+
+const chromeRequest = "GET / HTTP/1.1\r\n" +
+	"Host: example.com\r\n" +
+	"Connection: keep-alive\r\n" +
+	"Upgrade-Insecure-Requests: 1\r\n" +
+	"User-Agent: Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/60.0 Safari/537.36\r\n" +
+	"Accept: text/html,*/*\r\n" +
+	"Accept-Encoding: gzip, deflate, sdch\r\n" +
+	"Accept-Language: en-US,en;q=0.8\r\n" +
+	"\r\n"
+
+func TestDetectHTTP(t *testing.T) {
+	h, ok := ParseHTTP([]byte(chromeRequest))
+	if !ok || h.Method != "GET" || h.Version != 1 || len(h.Headers) != 7 {
+		t.Fatalf("ParseHTTP = %+v, %v", h, ok)
+	}
+	r := DetectHTTP(h)
+	if r.Label != "s:!:Chrome:51.x or newer" || r.Dishonest || r.UAOS != "Linux" {
+		t.Fatalf("got %+v", r)
+	}
+	if r.OSMismatch(Result{Label: "s:unix:Linux:3.11 and newer", Name: "Linux"}) {
+		t.Fatal("unexpected OS mismatch")
+	}
+	if !r.OSMismatch(Result{Label: "s:win:Windows:7 or 8", Name: "Windows"}) {
+		t.Fatal("expected OS mismatch")
+	}
+
+	h, _ = ParseHTTP([]byte(strings.Replace(chromeRequest, "Chrome/60.0 Safari", "Edge", 1)))
+	if r := DetectHTTP(h); r.Label != "s:!:Chrome:51.x or newer" || !r.Dishonest {
+		t.Fatalf("expected dishonest Chrome, got %+v", r)
+	}
+
+	h, _ = ParseHTTP([]byte(strings.Replace(chromeRequest, "Accept-Language: en-US,en;q=0.8\r\n", "Keep-Alive: 300\r\n", 1)))
+	if r := DetectHTTP(h); r.Label == "s:!:Chrome:51.x or newer" {
+		t.Fatalf("absent header should rule out Chrome, got %+v", r)
+	}
+}
+
+const nginxResponse = "HTTP/1.1 200 OK\r\n" +
+	"Server: nginx/1.18.0\r\n" +
+	"Date: Sun, 18 Oct 2026 10:00:00 GMT\r\n" +
+	"Content-Type: text/html\r\n" +
+	"Content-Length: 612\r\n" +
+	"Connection: keep-alive\r\n" +
+	"\r\n"
+
+func TestDetectHTTPServer(t *testing.T) {
+	h, ok := ParseHTTP([]byte(nginxResponse))
+	if !ok || !h.Response || h.Status != 200 || h.Version != 1 {
+		t.Fatalf("ParseHTTP = %+v, %v", h, ok)
+	}
+	r := DetectHTTPServer(h)
+	if r.Name != "nginx" || r.Dishonest || r.UA != "nginx/1.18.0" {
+		t.Fatalf("got %+v", r)
+	}
+	h, _ = ParseHTTP([]byte(strings.Replace(nginxResponse, "nginx/1.18.0", "Apache", 1)))
+	if r := DetectHTTPServer(h); r.Name != "nginx" || !r.Dishonest {
+		t.Fatalf("expected dishonest nginx, got %+v", r)
+	}
+}
+
+func TestHTTPTracker(t *testing.T) {
+	tr := NewHTTPTracker(16)
+	p := Packet{SrcIP: net.IPv4(10, 0, 0, 1), DstIP: net.IPv4(10, 0, 0, 2), SrcPort: 40000, DstPort: 80}
+	p.Meta.Flags = TCPAck | TCPPush
+	p.Payload = []byte(chromeRequest[:40])
+	if _, ok := tr.Feed(p); ok {
+		t.Fatal("incomplete header block reported")
+	}
+	p.Payload = []byte(chromeRequest[40:])
+	h, ok := tr.Feed(p)
+	if !ok || len(h.Headers) != 7 {
+		t.Fatalf("Feed = %+v, %v", h, ok)
+	}
+	p.Payload = []byte(chromeRequest)
+	if _, ok := tr.Feed(p); ok {
+		t.Fatal("second request on the flow reported")
+	}
+	p.SrcPort++
+	p.Payload = []byte("\x16\x03\x01")
+	if _, ok := tr.Feed(p); ok {
+		t.Fatal("non-HTTP flow reported")
+	}
+	p.Payload = []byte(chromeRequest)
+	if _, ok := tr.Feed(p); ok {
+		t.Fatal("HTTP after non-HTTP payload reported")
+	}
+	p.SrcIP, p.DstIP, p.SrcPort, p.DstPort = p.DstIP, p.SrcIP, 80, 40000
+	p.Payload = []byte(nginxResponse)
+	if h, ok := tr.Feed(p); !ok || !h.Response {
+		t.Fatalf("response Feed = %+v, %v", h, ok)
+	}
+}
+
+func TestOSMismatchStockApple(t *testing.T) {
+	apple := PacketMeta{Version: 4, TTL: 60, Win: 65535, MSS: 1460, Options: []string{"mss", "nop", "ws", "nop", "nop", "ts", "sok", "eol+1"}, Quirks: QuirkDF | QuirkNonZeroID}
+	uas := map[string]string{
+		"Mac OS X": "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Safari/605.1.15",
+		"iOS":      "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1",
+	}
+	for _, scale := range []int{1, 2, 3, 4, 6} {
+		apple.WScale = scale
+		tcp := Detect(apple)
+		if tcp.Label == "Unknown" {
+			t.Fatalf("scale %d: no tcp match", scale)
+		}
+		for os, ua := range uas {
+			h, _ := ParseHTTP([]byte(strings.Replace(chromeRequest, "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/60.0 Safari/537.36", ua, 1)))
+			r := DetectHTTP(h)
+			if r.UAOS != os {
+				t.Fatalf("UAOS(%q) = %q, want %q", ua, r.UAOS, os)
+			}
+			if r.OSMismatch(tcp) {
+				t.Errorf("%s user agent flagged against %s", os, tcp.Label)
+			}
+		}
+	}
+	win, _ := ParseHTTP([]byte(strings.Replace(chromeRequest, "X11; Linux x86_64", "Windows NT 10.0; Win64; x64", 1)))
+	if r := DetectHTTP(win); !r.OSMismatch(Detect(apple)) {
+		t.Fatalf("Windows user agent on an Apple stack not flagged: %+v", r)
+	}
+}
@@ -0,0 +1,297 @@
+package p0f
+
+import (
+	"math/bits"
+	"strings"
+)
+
+const (
+	optMSS uint8 = 1 << iota
+	optWS
+	optSOK
+	optSACK
+	optTS
+	optNOP
+	optEOL
+	optUnknown
+)
+
+type compiledSig struct {
+	Signature
+	label   string
+	generic bool
+	class   string
+	name    string
+	flavor  string
+	order   int
+}
+
+// sigBucket holds every signature sharing one ordered option layout.
+type sigBucket struct {
+	layout []string
+	mask   uint8
+	sigs   []compiledSig
+}
+
+// sigIndex finds the bucket for a packet's exact option layout by hash and,
+// for the fuzzy fallback, groups buckets by option set so whole groups that
+// cannot beat the best candidate are skipped.
+type sigIndex struct {
+	buckets  []sigBucket
+	byLayout map[uint64][]int
+	byMask   map[uint8][]int
+	masks    []uint8
+}
+
+func compileIndex(db *DB, section string) *sigIndex {
+	ix := &sigIndex{byLayout: make(map[uint64][]int), byMask: make(map[uint8][]int)}
+	order := 0
+	for _, e := range sectionEntries(db, section) {
+		generic, class, name, flavor := e.labelParts()
+		for _, sig := range e.tcpSigs() {
+			bi := ix.bucket(sig.Layout)
+			if bi < 0 {
+				bi = len(ix.buckets)
+				h := layoutHash(sig.Layout)
+				mask := optMask(sig.Layout)
+				ix.byLayout[h] = append(ix.byLayout[h], bi)
+				if _, ok := ix.byMask[mask]; !ok {
+					ix.masks = append(ix.masks, mask)
+				}
+				ix.byMask[mask] = append(ix.byMask[mask], bi)
+				ix.buckets = append(ix.buckets, sigBucket{layout: sig.Layout, mask: mask})
+			}
+			cs := compiledSig{Signature: sig, label: e.Label, generic: generic, class: class, name: name, flavor: flavor, order: order}
+			ix.buckets[bi].sigs = append(ix.buckets[bi].sigs, cs)
+			order++
+		}
+	}
+	return ix
+}
+
+func (ix *sigIndex) bucket(layout []string) int {
+	for _, bi := range ix.byLayout[layoutHash(layout)] {
+		if sameLayout(ix.buckets[bi].layout, layout) {
+			return bi
+		}
+	}
+	return -1
+}
+
+// match scores the signatures sharing the packet's option layout and, when
+// none of them is acceptable, fuzzy is set and the profile is not Exact,
+// falls back to every other layout ranked by option-set similarity. Within
+// the packet's layout the MatchKind precedence decides before the score.
+func (ix *sigIndex) match(m PacketMeta, fuzzy bool, p *ScoringProfile) (*compiledSig, float64) {
+	maxFieldScore := p.maxField()
+	var best *compiledSig
+	bestKind := MatchNone
+	bestScore := -1.0
+	visit := func(b *sigBucket, layout float64, exactLayout bool) {
+		for i := range b.sigs {
+			cs := &b.sigs[i]
+			// Score only prunes signatures that cannot outrank best by
+			// kind: those of other layouts, or any once best is exact.
+			if best != nil && (bestKind == MatchExact || !exactLayout) && cs.order > best.order && layout+maxFieldScore <= bestScore {
+				break
+			}
+			fs, ok := fieldScore(&cs.Signature, m, p)
+			if !ok {
+				continue
+			}
+			score := layout + fs
+			kind := MatchFuzzy
+			if exactLayout {
+				kind = cs.kind(m)
+			}
+			if outranks(cs, kind, score, best, bestKind, bestScore) {
+				best, bestKind, bestScore = cs, kind, score
+			}
+		}
+	}
+	exact := ix.bucket(m.Options)
+	if exact >= 0 {
+		visit(&ix.buckets[exact], p.LayoutExact, true)
+	}
+	if best != nil || !fuzzy || p.Exact {
+		return best, bestScore
+	}
+	mask := optMask(m.Options)
+	for _, sm := range ix.masks {
+		layout := fuzzyLayoutScore(sm, mask, p)
+		if layout+maxFieldScore < bestScore {
+			continue
+		}
+		for _, bi := range ix.byMask[sm] {
+			if bi != exact {
+				visit(&ix.buckets[bi], layout, false)
+			}
+		}
+	}
+	return best, bestScore
+}
+
+// matchN ranks like match but keeps the best signature of each of the
+// len(top) best labels in top, best first, and returns how many it filled.
+// Once top is full, signatures that cannot beat its last entry are skipped;
+// the fallback visits option sets most similar first so that happens early.
+func (ix *sigIndex) matchN(m PacketMeta, fuzzy bool, p *ScoringProfile, top []ranked) int {
+	maxFieldScore := p.maxField()
+	n := 0
+	beaten := func(layout float64, kind MatchKind) bool {
+		last := &top[len(top)-1]
+		return n == len(top) && last.kind >= kind && layout+maxFieldScore < last.score
+	}
+	visit := func(b *sigBucket, layout float64, exactLayout bool) {
+		kind := MatchFuzzy
+		if exactLayout {
+			kind = MatchExact
+		}
+		for i := range b.sigs {
+			if beaten(layout, kind) {
+				return
+			}
+			cs := &b.sigs[i]
+			fs, ok := fieldScore(&cs.Signature, m, p)
+			if !ok {
+				continue
+			}
+			r := ranked{cs: cs, kind: MatchFuzzy, score: layout + fs}
+			if exactLayout {
+				r.kind = cs.kind(m)
+			}
+			n = insertRanked(top, n, r)
+		}
+	}
+	exact := ix.bucket(m.Options)
+	if exact >= 0 {
+		visit(&ix.buckets[exact], p.LayoutExact, true)
+	}
+	if n > 0 || !fuzzy || p.Exact {
+		return n
+	}
+	var groups [256]struct {
+		layout float64
+		mask   uint8
+	}
+	mask := optMask(m.Options)
+	for i, sm := range ix.masks {
+		g := groups[i]
+		g.layout, g.mask = fuzzyLayoutScore(sm, mask, p), sm
+		j := i
+		for ; j > 0 && groups[j-1].layout < g.layout; j-- {
+			groups[j] = groups[j-1]
+		}
+		groups[j] = g
+	}
+	for _, g := range groups[:len(ix.masks)] {
+		if beaten(g.layout, MatchFuzzy) {
+			break
+		}
+		for _, bi := range ix.byMask[g.mask] {
+			if bi != exact {
+				visit(&ix.buckets[bi], g.layout, false)
+			}
+		}
+	}
+	return n
+}
+
+// insertRanked adds r to the first n entries of top, which are best first
+// with one per label, dropping the last entry when top is full, and returns
+// the new count.
+func insertRanked(top []ranked, n int, r ranked) int {
+	for i := 0; i < n; i++ {
+		if top[i].cs.label == r.cs.label {
+			if !r.before(top[i]) {
+				return n
+			}
+			copy(top[i:], top[i+1:n])
+			n--
+			break
+		}
+	}
+	i := n
+	for i > 0 && r.before(top[i-1]) {
+		i--
+	}
+	if i == len(top) {
+		return n
+	}
+	if n < len(top) {
+		n++
+	}
+	copy(top[i+1:n], top[i:n-1])
+	top[i] = r
+	return n
+}
+
+func layoutHash(opts []string) uint64 {
+	h := uint64(14695981039346656037)
+	for _, o := range opts {
+		for i := 0; i < len(o); i++ {
+			h ^= uint64(o[i])
+			h *= 1099511628211
+		}
+		h ^= ','
+		h *= 1099511628211
+	}
+	return h
+}
+
+func sameLayout(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func optMask(opts []string) uint8 {
+	var mask uint8
+	for _, o := range opts {
+		switch {
+		case o == "mss":
+			mask |= optMSS
+		case o == "ws":
+			mask |= optWS
+		case o == "sok":
+			mask |= optSOK
+		case o == "sack":
+			mask |= optSACK
+		case o == "ts":
+			mask |= optTS
+		case o == "nop":
+			mask |= optNOP
+		case strings.HasPrefix(o, "eol"):
+			mask |= optEOL
+		case strings.HasPrefix(o, "?"):
+			mask |= optUnknown
+		}
+	}
+	return mask
+}
+
+// fuzzyLayoutScore rates two different layouts by the Jaccard similarity of
+// their option sets.
+func fuzzyLayoutScore(sig uint8, pkt uint8, p *ScoringProfile) float64 {
+	sim := layoutSimilarity(sig, pkt)
+	score := p.LayoutOverlap * sim
+	if sim > 0.5 {
+		score += p.LayoutSimilar
+	}
+	return score
+}
+
+// layoutSimilarity is the Jaccard similarity of two option sets, 0 when
+// either is empty.
+func layoutSimilarity(sig uint8, pkt uint8) float64 {
+	if sig == 0 || pkt == 0 {
+		return 0
+	}
+	return float64(bits.OnesCount8(sig&pkt)) / float64(bits.OnesCount8(sig|pkt))
+}
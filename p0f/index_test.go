@@ -0,0 +1,131 @@
+package p0f
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func linearMatch(ix *sigIndex, m PacketMeta, p *ScoringProfile) *compiledSig {
+	var best *compiledSig
+	bestKind := MatchNone
+	bestScore := -1.0
+	for _, exactPass := range []bool{true, false} {
+		for bi := range ix.buckets {
+			b := &ix.buckets[bi]
+			if sameLayout(b.layout, m.Options) != exactPass {
+				continue
+			}
+			layout := p.LayoutExact
+			if !exactPass {
+				layout = fuzzyLayoutScore(b.mask, optMask(m.Options), p)
+			}
+			for i := range b.sigs {
+				cs := &b.sigs[i]
+				fs, ok := fieldScore(&cs.Signature, m, p)
+				if !ok {
+					continue
+				}
+				score := layout + fs
+				kind := cs.kind(m)
+				if kind > bestKind || kind == bestKind && (score > bestScore || score == bestScore && cs.order < best.order) {
+					best, bestKind, bestScore = cs, kind, score
+				}
+			}
+		}
+		if best != nil {
+			break
+		}
+	}
+	return best
+}
+
+func TestIndexMatchesLinearScan(t *testing.T) {
+	ix := Default().c.tcpRequest
+	names := []string{"mss", "ws", "sok", "ts", "nop", "sack", "eol+1", "?30"}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		m := PacketMeta{
+			TTL:    []int{30, 50, 64, 100, 128, 200, 255}[rng.Intn(7)],
+			Win:    []uint16{1024, 8192, 16384, 29200, 64240, 65535, uint16(rng.Intn(65536))}[rng.Intn(7)],
+			MSS:    []uint16{0, 536, 1360, 1460}[rng.Intn(4)],
+			WScale: rng.Intn(11),
+			Quirks: []Quirks{0, QuirkDF | QuirkNonZeroID, QuirkZeroID | QuirkECN, QuirkNonZeroAck}[rng.Intn(4)],
+		}
+		for j := rng.Intn(7); j > 0; j-- {
+			m.Options = append(m.Options, names[rng.Intn(len(names))])
+		}
+		if rng.Intn(4) == 0 {
+			m.Options = []string{"mss", "nop", "ws", "nop", "nop", "ts", "sok", "eol+1"}
+		}
+		got, _ := ix.match(m, true, &DefaultProfile)
+		want := linearMatch(ix, m, &DefaultProfile)
+		if got != want {
+			t.Fatalf("%+v: index picked %v, linear scan picked %v", m, got, want)
+		}
+	}
+}
+
+func syntheticDB(copies int) *DB {
+	db := &DB{Entries: append([]Entry(nil), Data.Entries...)}
+	for c := 0; c < copies; c++ {
+		for _, e := range Data.Entries {
+			if e.Section != "tcp:request" {
+				continue
+			}
+			var sigs []string
+			for i := range e.Sig {
+				sigs = append(sigs, fmt.Sprintf("*:128:0:*:%d,%d:mss,nop,ws,nop,nop,sok:df,id+:0", 1024+c*64+i, c%15))
+			}
+			db.Entries = append(db.Entries, Entry{Section: e.Section, Label: fmt.Sprintf("%s #%d", e.Label, c), Sig: sigs})
+		}
+	}
+	return db
+}
+
+func BenchmarkDetect(b *testing.B) {
+	linux := PacketMeta{TTL: 61, Win: 29200, MSS: 1460, WScale: 7, Options: []string{"mss", "sok", "ts", "nop", "ws"}, Quirks: QuirkDF | QuirkNonZeroID}
+	// grown lands in the bucket syntheticDB adds to and matches none of it
+	// exactly, so the whole bucket is scored; fuzzy has a layout no
+	// signature uses and takes the fallback.
+	grown := PacketMeta{TTL: 120, Win: 1001, MSS: 1460, WScale: 14, Options: []string{"mss", "nop", "ws", "nop", "nop", "sok"}, Quirks: QuirkDF | QuirkNonZeroID}
+	fuzzy := PacketMeta{TTL: 61, Win: 29200, MSS: 1460, WScale: 7, Options: []string{"mss", "ws", "sok", "ts", "nop"}, Quirks: QuirkDF | QuirkNonZeroID}
+	packets := []struct {
+		name string
+		m    PacketMeta
+	}{{"linux", linux}, {"grown", grown}, {"fuzzy", fuzzy}}
+	for _, copies := range []int{0, 4, 16, 64} {
+		ix := compileIndex(syntheticDB(copies), "tcp:request")
+		n := 0
+		for _, bk := range ix.buckets {
+			n += len(bk.sigs)
+		}
+		for _, p := range packets {
+			m := p.m
+			if cs, _ := ix.match(m, true, &DefaultProfile); cs == nil {
+				b.Fatalf("%s: no match", p.name)
+			}
+			if p.name == "fuzzy" && ix.bucket(m.Options) >= 0 {
+				b.Fatalf("fuzzy packet has an exact layout bucket")
+			}
+			b.Run(fmt.Sprintf("%s/sigs=%d", p.name, n), func(b *testing.B) {
+				if a := testing.AllocsPerRun(10, func() { ix.match(m, true, &DefaultProfile) }); a != 0 {
+					b.Fatalf("match allocates %v times", a)
+				}
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					ix.match(m, true, &DefaultProfile)
+				}
+			})
+			b.Run(fmt.Sprintf("%s/top3/sigs=%d", p.name, n), func(b *testing.B) {
+				if a := testing.AllocsPerRun(10, func() { topN(ix, m, true, &DefaultProfile, 3) }); a != 1 {
+					b.Fatalf("topN allocates %v times", a)
+				}
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					topN(ix, m, true, &DefaultProfile, 3)
+				}
+			})
+		}
+	}
+}
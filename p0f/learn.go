@@ -0,0 +1,147 @@
+package p0f
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Learner generalises SYN or SYN+ACK observations of one known stack into
+// tcp signatures. Packets are grouped by option layout, quirks, IP option
+// length and payload class, the fields that identify a stack and that
+// p0f never relaxes; within a group, fields that vary are
+// widened until every observation matches.
+type Learner struct {
+	groups map[string]*learnGroup
+}
+
+type learnGroup struct {
+	layout  []string
+	quirks  Quirks
+	olen    int
+	pclass  string
+	count   int
+	first   int
+	samples map[learnSample]int
+}
+
+// learnSample is the part of a packet that is generalised.
+type learnSample struct {
+	version int
+	ttl     int
+	win     int
+	mss     int
+	scale   int
+}
+
+// LearnedSig is a signature produced by Learner and the number of packets
+// it covers.
+type LearnedSig struct {
+	Sig   string
+	Count int
+}
+
+// Add records one observation.
+func (l *Learner) Add(m PacketMeta) {
+	if l.groups == nil {
+		l.groups = make(map[string]*learnGroup)
+	}
+	pc := pclass(m.PayloadLen)
+	key := fmt.Sprintf("%s:%s:%d:%s", strings.Join(m.Options, ","), m.Quirks, m.IPOptLen, pc)
+	g := l.groups[key]
+	if g == nil {
+		g = &learnGroup{layout: m.Options, quirks: m.Quirks, olen: m.IPOptLen, pclass: pc, first: len(l.groups), samples: make(map[learnSample]int)}
+		l.groups[key] = g
+	}
+	g.count++
+	g.samples[learnSample{m.Version, m.TTL, int(m.Win), int(m.MSS), m.WScale}]++
+}
+
+// Signatures returns one signature per group, most observed first.
+func (l *Learner) Signatures() []LearnedSig {
+	groups := make([]*learnGroup, 0, len(l.groups))
+	for _, g := range l.groups {
+		groups = append(groups, g)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].count != groups[j].count {
+			return groups[i].count > groups[j].count
+		}
+		return groups[i].first < groups[j].first
+	})
+	var out []LearnedSig
+	for _, g := range groups {
+		out = append(out, LearnedSig{Sig: g.signature(), Count: g.count})
+	}
+	return out
+}
+
+func (g *learnGroup) signature() string {
+	versions := map[int]bool{}
+	ittls := map[int]bool{}
+	scales := map[int]bool{}
+	ittl := 0
+	for s := range g.samples {
+		versions[s.version] = true
+		scales[s.scale] = true
+		t := guessTTL(s.ttl)
+		ittls[t] = true
+		ittl = max(ittl, t)
+	}
+	ver := "*"
+	if len(versions) == 1 {
+		for v := range versions {
+			if v == 4 || v == 6 {
+				ver = fmt.Sprint(v)
+			}
+		}
+	}
+	// Observations that guess different initial TTLs only share the
+	// highest one as an upper bound.
+	ttl := fmt.Sprint(ittl)
+	if len(ittls) > 1 {
+		ttl += "-"
+	}
+	scale := "*"
+	if len(scales) == 1 {
+		for s := range scales {
+			scale = fmt.Sprint(s)
+		}
+	}
+	return fmt.Sprintf("%s:%s:%d:*:%s,%s:%s:%s:%s", ver, ttl, g.olen, g.window(), scale, strings.Join(g.layout, ","), g.quirks, g.pclass)
+}
+
+// window picks the first form every observation agrees on: a multiple of
+// the MSS, a multiple of the MTU, a constant, or "*".
+func (g *learnGroup) window() string {
+	mult := func(unit func(learnSample) int) int {
+		n := -1
+		for s := range g.samples {
+			u := unit(s)
+			if u <= 0 || s.win == 0 || s.win%u != 0 || n >= 0 && s.win/u != n {
+				return -1
+			}
+			n = s.win / u
+		}
+		return n
+	}
+	if n := mult(func(s learnSample) int { return s.mss }); n > 0 {
+		return fmt.Sprintf("mss*%d", n)
+	}
+	if n := mult(func(s learnSample) int {
+		if s.mss == 0 {
+			return 0
+		}
+		return s.mss + mtuHeader(s.version)
+	}); n > 0 {
+		return fmt.Sprintf("mtu*%d", n)
+	}
+	win := -1
+	for s := range g.samples {
+		if win >= 0 && s.win != win {
+			return "*"
+		}
+		win = s.win
+	}
+	return fmt.Sprint(win)
+}
@@ -0,0 +1,48 @@
+package p0f
+
+import "testing"
+
+func TestLearner(t *testing.T) {
+	linux := PacketMeta{Version: 4, TTL: 61, Win: 29200, MSS: 1460, WScale: 7, Options: []string{"mss", "sok", "ts", "nop", "ws"}, Quirks: QuirkDF | QuirkNonZeroID}
+	vpn := linux
+	vpn.TTL, vpn.Win, vpn.MSS = 50, 27200, 1360
+	scaled := linux
+	scaled.WScale = 9
+	odd := linux
+	odd.Options, odd.Win = []string{"mss", "nop", "ws"}, 5000
+	odd2 := odd
+	odd2.Win, odd2.TTL = 6000, 120
+	tfo := linux
+	tfo.PayloadLen = 60
+
+	var l Learner
+	for _, m := range []PacketMeta{linux, vpn, linux, scaled, odd, odd2, tfo} {
+		l.Add(m)
+	}
+	got := l.Signatures()
+	want := []LearnedSig{
+		{"4:64:0:*:mss*20,*:mss,sok,ts,nop,ws:df,id+:0", 4},
+		{"4:128-:0:*:*,7:mss,nop,ws:df,id+:0", 2},
+		{"4:64:0:*:mss*20,7:mss,sok,ts,nop,ws:df,id+:+", 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("signature %d = %v, want %v", i, got[i], want[i])
+		}
+		sig, err := ParseSignature(got[i].Sig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range []PacketMeta{linux, vpn, scaled, odd, odd2, tfo} {
+			if !sameLayout(sig.Layout, m.Options) || !pclassMatch(sig.PClass, m.PayloadLen) {
+				continue
+			}
+			if _, ok := fieldScore(&sig, m, &DefaultProfile); !ok || !ttlMatch(&sig, m.TTL) || !winEquals(&sig, int(m.Win), int(m.MSS), m.Version) {
+				t.Fatalf("%s does not cover %+v", got[i].Sig, m)
+			}
+		}
+	}
+}
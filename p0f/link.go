@@ -0,0 +1,43 @@
+package p0f
+
+import "strconv"
+
+// compileMTU maps each MTU in the [mtu] section to its link label; the first
+// entry listing an MTU, by priority and then file order, wins.
+func compileMTU(db *DB) map[int]string {
+	ix := make(map[int]string)
+	for _, e := range sectionEntries(db, "mtu") {
+		for _, s := range e.Sig {
+			mtu, err := strconv.Atoi(s)
+			if err != nil {
+				continue
+			}
+			if _, ok := ix[mtu]; !ok {
+				ix[mtu] = e.Label
+			}
+		}
+	}
+	return ix
+}
+
+// MTU returns the link MTU implied by an MSS, adding the minimal IP and TCP
+// header sizes for the IP version. It returns 0 when mss is 0.
+func MTU(mss uint16, version int) int {
+	if mss == 0 {
+		return 0
+	}
+	return int(mss) + mtuHeader(version)
+}
+
+func mtuHeader(version int) int {
+	if version == 6 {
+		return 60
+	}
+	return 40
+}
+
+// DetectLink labels the link a SYN came through from the MSS it advertised,
+// using the [mtu] section of the default Detector.
+func DetectLink(mss uint16, version int) string {
+	return Default().DetectLink(mss, version)
+}
@@ -0,0 +1,26 @@
+package p0f
+
+import "testing"
+
+func TestDetectLink(t *testing.T) {
+	cases := []struct {
+		mss     uint16
+		version int
+		want    string
+	}{
+		{1460, 4, "Ethernet or modem"},
+		{1440, 6, "Ethernet or modem"},
+		{1452, 4, "DSL"},
+		{1400, 4, "generic tunnel or VPN"},
+		{1436, 4, "IPSec or GRE"},
+		{1380, 6, "generic tunnel or VPN"},
+		{8960, 4, "jumbo Ethernet"},
+		{1234, 4, "Unknown"},
+		{0, 4, ""},
+	}
+	for _, c := range cases {
+		if got := DetectLink(c.mss, c.version); got != c.want {
+			t.Errorf("DetectLink(%d, %d) = %q, want %q", c.mss, c.version, got, c.want)
+		}
+	}
+}
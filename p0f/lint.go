@@ -0,0 +1,176 @@
+package p0f
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Diagnostic is one problem Lint found in a p0f.fp file.
+type Diagnostic struct {
+	File string
+	Line int
+	Msg  string
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s:%d: %s", d.File, d.Line, d.Msg)
+}
+
+// LintFiles checks p0f.fp files merged in order as LoadDBFiles would. It
+// reports every line that does not follow the grammar, duplicate
+// signatures, signatures that an earlier one always beats, and labels that
+// can therefore never be reported. The error is only set when a file
+// cannot be read.
+func LintFiles(paths ...string) ([]Diagnostic, error) {
+	l := &dbLoader{db: &DB{}, lenient: true}
+	if err := l.readFiles(paths); err != nil {
+		return nil, err
+	}
+	if _, err := l.finish(); err != nil {
+		l.errs = append(l.errs, err)
+	}
+	var out []Diagnostic
+	for _, err := range l.errs {
+		var pe *ParseError
+		if errors.As(err, &pe) {
+			out = append(out, Diagnostic{File: pe.File, Line: pe.Line, Msg: pe.Err.Error()})
+		}
+	}
+	for _, section := range []string{"mtu", "tcp:request", "tcp:response", "http:request", "http:response"} {
+		out = append(out, l.lintSection(section)...)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].File != out[j].File {
+			return out[i].File < out[j].File
+		}
+		return out[i].Line < out[j].Line
+	})
+	return out, nil
+}
+
+// lintSig is a valid signature in match order.
+type lintSig struct {
+	entry   int
+	line    int
+	generic bool
+	tcp     Signature
+	http    HTTPSignature
+	mtu     int
+}
+
+// lintSection walks the section in the order the matchers try it and flags
+// each signature that an earlier one matches whenever it does, with at
+// least the same score.
+func (l *dbLoader) lintSection(section string) []Diagnostic {
+	var order []int
+	for i, e := range l.db.Entries {
+		if e.Section == section {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return l.db.Entries[order[a]].Priority > l.db.Entries[order[b]].Priority
+	})
+	var out []Diagnostic
+	var seen []lintSig
+	for _, ei := range order {
+		e := &l.db.Entries[ei]
+		o := &l.origins[ei]
+		if len(e.Sig) == 0 {
+			out = append(out, Diagnostic{o.file, o.line, fmt.Sprintf("label %q has no signatures", e.Label)})
+			continue
+		}
+		generic, _, _, _ := e.labelParts()
+		live := false
+		for si, raw := range e.Sig {
+			cur := lintSig{entry: ei, line: o.sigLines[si], generic: generic}
+			switch section {
+			case "mtu":
+				cur.mtu, _ = strconv.Atoi(raw)
+			case "tcp:request", "tcp:response":
+				cur.tcp = e.TCP[si]
+			default:
+				cur.http = e.HTTP[si]
+			}
+			var by *lintSig
+			dup := false
+			for i := range seen {
+				if sigShadows(section, &seen[i], &cur) {
+					by = &seen[i]
+					dup = sigShadows(section, &cur, by)
+					break
+				}
+			}
+			if by == nil {
+				live = true
+				seen = append(seen, cur)
+				continue
+			}
+			if by.entry == ei {
+				live = true
+			}
+			bo := &l.origins[by.entry]
+			what := "shadowed by"
+			if dup {
+				what = "duplicate of"
+			}
+			out = append(out, Diagnostic{o.file, cur.line, fmt.Sprintf("signature %q is %s %s:%d (%s)", raw, what, bo.file, by.line, l.db.Entries[by.entry].Label)})
+		}
+		if !live {
+			out = append(out, Diagnostic{o.file, o.line, fmt.Sprintf("label %q can never win: every signature is shadowed", e.Label)})
+		}
+	}
+	return out
+}
+
+// sigShadows reports whether a, tried before b, wins every packet b
+// matches.
+func sigShadows(section string, a, b *lintSig) bool {
+	switch section {
+	case "mtu":
+		return a.mtu == b.mtu
+	case "tcp:request", "tcp:response":
+		// An exact match of a specific label beats a generic one tried
+		// before it.
+		return (!a.generic || b.generic) && tcpShadows(&a.tcp, &b.tcp)
+	}
+	return httpShadows(&a.http, &b.http)
+}
+
+// tcpShadows requires the fields that earn points to be equal and the
+// fields that only accept or reject a packet to be at least as permissive.
+func tcpShadows(a, b *Signature) bool {
+	return sameLayout(a.Layout, b.Layout) && a.Quirks == b.Quirks &&
+		a.TTL == b.TTL && (a.BadTTL || !b.BadTTL) &&
+		a.WinType == b.WinType && a.Win == b.Win && a.Scale == b.Scale &&
+		(a.Version == Any || a.Version == b.Version) &&
+		(a.MSS == Any || a.MSS == b.MSS) &&
+		a.OLen == b.OLen && (a.PClass == Any || a.PClass == b.PClass)
+}
+
+// httpShadows follows matchHTTP's first-match rule: a wins whenever its
+// layout matches and it expects no other software than b.
+func httpShadows(a, b *HTTPSignature) bool {
+	if a.Version != Any && a.Version != b.Version {
+		return false
+	}
+	if a.Software != "" && a.Software != b.Software {
+		return false
+	}
+	if len(a.Order) != len(b.Order) || len(a.Absent) != len(b.Absent) {
+		return false
+	}
+	for i := range a.Order {
+		if a.Order[i] != b.Order[i] {
+			return false
+		}
+	}
+	for i := range a.Absent {
+		if a.Absent[i] != b.Absent[i] {
+			return false
+		}
+	}
+	return true
+}
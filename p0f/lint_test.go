@@ -0,0 +1,98 @@
+package p0f
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLintFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lint.fp")
+	in := `classes = unix
+[tcp:request]
+sig = *:64:0:*:1,0:mss:df:0
+label = s:unix:Bad:1
+sig = *:64:0:*:1,0:mss,bogus:df:0
+label = s:unix:General:1
+sig = *:64:0:*:1,0:mss:df:0
+sig = *:64:0:*:1,0:mss:df:0
+label = s:unix:Specific:1
+sig = 4:64:0:1460:1,0:mss:df:0
+label = s:unix:Alive:1
+sig = 4:64:0:1460:1,0:mss:df:0
+sig = *:64:0:*:2,0:mss:df:0
+[http:request]
+label = s:!:curl:
+sig = *:Host,User-Agent:Accept:curl
+label = s:!:curl:too
+sig = 1:Host,User-Agent:Accept:curl
+[udp]
+label = ignored
+`
+	if err := os.WriteFile(path, []byte(in), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	diags, err := LintFiles(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		line int
+		msg  string
+	}{
+		{3, "sig before label"},
+		{4, `label "s:unix:Bad:1" has no signatures`},
+		{5, `unknown option "bogus"`},
+		{8, "is duplicate of " + path + ":7 (s:unix:General:1)"},
+		{9, `label "s:unix:Specific:1" can never win`},
+		{10, "is shadowed by " + path + ":7"},
+		{12, "is shadowed by"},
+		{17, `label "s:!:curl:too" can never win`},
+		{18, "is shadowed by " + path + ":16"},
+		{19, `unknown section "udp"`},
+	}
+	if len(diags) != len(want) {
+		t.Fatalf("got %d diagnostics, want %d:\n%v", len(diags), len(want), diags)
+	}
+	for i, w := range want {
+		d := diags[i]
+		if d.File != path || d.Line != w.line || !strings.Contains(d.Msg, w.msg) {
+			t.Errorf("diagnostic %d = %v, want line %d with %q", i, d, w.line, w.msg)
+		}
+	}
+}
+
+func TestLintGenericBeforeSpecific(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lint.fp")
+	in := `classes = unix
+[tcp:request]
+label = g:unix:Linux:
+sig = *:64:0:*:1,0:mss:df:0
+label = s:unix:Foo:1
+sig = *:64:0:*:1,0:mss:df:0
+label = g:unix:Bar:
+sig = *:64:0:*:1,0:mss:df:0
+`
+	if err := os.WriteFile(path, []byte(in), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	diags, err := LintFiles(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Foo wins exact matches over the earlier generic label; Bar, generic
+	// itself, loses to both.
+	if len(diags) != 2 || diags[0].Line != 7 || !strings.Contains(diags[0].Msg, `"g:unix:Bar:" can never win`) ||
+		diags[1].Line != 8 || !strings.Contains(diags[1].Msg, "duplicate of "+path+":4 (g:unix:Linux:)") {
+		t.Fatalf("got %v", diags)
+	}
+	db, err := LoadDBFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := PacketMeta{TTL: 64, Win: 1, MSS: 1460, Options: []string{"mss"}, Quirks: QuirkDF}
+	if r := NewDetector(db, DefaultOptions()).Detect(m); r.Label != "s:unix:Foo:1" || r.Match != MatchExact {
+		t.Fatalf("Detect = %+v", r)
+	}
+}
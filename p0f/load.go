@@ -0,0 +1,395 @@
+package p0f
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ParseError reports a problem in a p0f.fp database at a given line.
+type ParseError struct {
+	File string
+	Line int
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	if e.File == "" {
+		return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("%s:%d: %v", e.File, e.Line, e.Err)
+}
+
+func (e *ParseError) Unwrap() error { return e.Err }
+
+var sections = map[string]bool{
+	"mtu":           true,
+	"tcp:request":   true,
+	"tcp:response":  true,
+	"http:request":  true,
+	"http:response": true,
+}
+
+// LoadDBFile reads a p0f.fp database from path.
+func LoadDBFile(path string) (*DB, error) {
+	return LoadDBFiles(path)
+}
+
+// LoadDBFiles reads p0f.fp files and merges them in order. A later file
+// can declare more classes and ua_os items, add labels, shadow an earlier
+// file's entry by redefining its label in the same section, and drop one
+// with "disable = label". Errors carry the file and line.
+func LoadDBFiles(paths ...string) (*DB, error) {
+	l := &dbLoader{db: &DB{}}
+	if err := l.readFiles(paths); err != nil {
+		return nil, err
+	}
+	return l.finish()
+}
+
+// LoadDB reads a p0f.fp database. Every signature is checked against the
+// grammar of its section; errors are *ParseError values carrying the line.
+func LoadDB(r io.Reader) (*DB, error) {
+	l := &dbLoader{db: &DB{}}
+	if err := l.read(r, ""); err != nil {
+		return nil, err
+	}
+	return l.finish()
+}
+
+// dbLoader accumulates one or more files into a DB. origins runs parallel
+// to db.Entries. A lenient loader records bad lines in errs and carries on
+// instead of stopping at the first one.
+type dbLoader struct {
+	db      *DB
+	files   int
+	origins []entryOrigin
+	lenient bool
+	errs    []error
+}
+
+type entryOrigin struct {
+	file     string
+	fileIdx  int
+	line     int
+	sysLine  int
+	sigLines []int
+}
+
+// fileState is the parser position within one file. badSection and skip
+// drop the lines of a section or entry whose header a lenient loader
+// rejected.
+type fileState struct {
+	file       string
+	fileIdx    int
+	section    string
+	cur        int
+	badSection bool
+	skip       bool
+}
+
+func (l *dbLoader) readFiles(paths []string) error {
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		err = l.read(f, path)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *dbLoader) read(r io.Reader, file string) error {
+	st := &fileState{file: file, fileIdx: l.files, cur: -1}
+	l.files++
+	lineNo := 0
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		lineNo++
+		t := strings.TrimSpace(sc.Text())
+		if t == "" || strings.HasPrefix(t, ";") {
+			continue
+		}
+		if err := l.line(st, t, lineNo); err != nil {
+			pe := &ParseError{File: file, Line: lineNo, Err: err}
+			if !l.lenient {
+				return pe
+			}
+			l.errs = append(l.errs, pe)
+		}
+	}
+	return sc.Err()
+}
+
+func (l *dbLoader) line(st *fileState, t string, lineNo int) error {
+	db := l.db
+	if strings.HasPrefix(t, "[") && strings.HasSuffix(t, "]") {
+		st.section, st.cur, st.skip = t[1:len(t)-1], -1, false
+		st.badSection = !sections[st.section]
+		if st.badSection {
+			return fmt.Errorf("unknown section %q", st.section)
+		}
+		return nil
+	}
+	key, val, ok := strings.Cut(t, "=")
+	if !ok {
+		return fmt.Errorf("expected key = value, got %q", t)
+	}
+	key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+	if st.badSection || st.skip && key != "label" && key != "disable" {
+		return nil
+	}
+	section := st.section
+	switch key {
+	case "classes":
+		classes := splitList(val)
+		if len(classes) == 0 {
+			return fmt.Errorf("empty classes")
+		}
+		for _, c := range classes {
+			if !contains(db.Classes, c) {
+				db.Classes = append(db.Classes, c)
+			}
+		}
+	case "ua_os":
+		if section != "http:request" {
+			return fmt.Errorf("ua_os outside [http:request]")
+		}
+		m, err := parseUAOS(val)
+		if err != nil {
+			return err
+		}
+		db.UAOS = append(db.UAOS, m...)
+	case "label":
+		if section == "" {
+			return fmt.Errorf("label outside a section")
+		}
+		st.cur, st.skip = -1, false
+		if err := checkLabel(section, val, db.Classes); err != nil {
+			st.skip = true
+			return err
+		}
+		st.cur = l.shadow(section, val, st.fileIdx)
+		if st.cur < 0 {
+			db.Entries = append(db.Entries, Entry{Section: section, Label: val})
+			l.origins = append(l.origins, entryOrigin{})
+			st.cur = len(db.Entries) - 1
+		}
+		if section != "mtu" {
+			e := &db.Entries[st.cur]
+			e.Generic, e.Class, e.Name, e.Flavor = splitLabel(val)
+		}
+		l.origins[st.cur] = entryOrigin{file: st.file, fileIdx: st.fileIdx, line: lineNo}
+	case "disable":
+		if section == "" {
+			return fmt.Errorf("disable outside a section")
+		}
+		st.cur, st.skip = -1, false
+		if !l.disable(section, val, st.fileIdx) {
+			return fmt.Errorf("disable: no earlier entry %q in [%s]", val, section)
+		}
+	case "prio":
+		if st.cur < 0 {
+			return fmt.Errorf("prio before label")
+		}
+		n, err := strconv.Atoi(val)
+		if err != nil {
+			return fmt.Errorf("bad prio %q", val)
+		}
+		db.Entries[st.cur].Priority = n
+	case "sys":
+		if st.cur < 0 {
+			return fmt.Errorf("sys before label")
+		}
+		db.Entries[st.cur].Sys = splitList(val)
+		l.origins[st.cur].sysLine = lineNo
+	case "sig":
+		if st.cur < 0 {
+			return fmt.Errorf("sig before label")
+		}
+		if err := addSig(&db.Entries[st.cur], val); err != nil {
+			return fmt.Errorf("bad signature %q: %v", val, err)
+		}
+		l.origins[st.cur].sigLines = append(l.origins[st.cur].sigLines, lineNo)
+	default:
+		return fmt.Errorf("unknown directive %q", key)
+	}
+	return nil
+}
+
+// shadow clears the entries that earlier files defined under label in
+// section, keeping the position of the first one for the new definition.
+// It returns that position, or -1 when there is nothing to shadow.
+func (l *dbLoader) shadow(section, label string, fileIdx int) int {
+	first := -1
+	for i := 0; i < len(l.db.Entries); i++ {
+		e := &l.db.Entries[i]
+		if e.Section != section || e.Label != label || l.origins[i].fileIdx == fileIdx {
+			continue
+		}
+		if first < 0 {
+			first = i
+			*e = Entry{Section: section, Label: label}
+			continue
+		}
+		l.remove(i)
+		i--
+	}
+	return first
+}
+
+// disable drops the entries that earlier files defined under label in
+// section and reports whether there were any.
+func (l *dbLoader) disable(section, label string, fileIdx int) bool {
+	found := false
+	for i := 0; i < len(l.db.Entries); i++ {
+		e := &l.db.Entries[i]
+		if e.Section == section && e.Label == label && l.origins[i].fileIdx != fileIdx {
+			l.remove(i)
+			i--
+			found = true
+		}
+	}
+	return found
+}
+
+func (l *dbLoader) remove(i int) {
+	l.db.Entries = append(l.db.Entries[:i], l.db.Entries[i+1:]...)
+	l.origins = append(l.origins[:i], l.origins[i+1:]...)
+}
+
+func (l *dbLoader) finish() (*DB, error) {
+	if i, err := expandSys(l.db); err != nil {
+		o := l.origins[i]
+		return nil, &ParseError{File: o.file, Line: o.sysLine, Err: err}
+	}
+	return l.db, nil
+}
+
+func checkLabel(section, label string, classes []string) error {
+	if section == "mtu" {
+		if label == "" {
+			return fmt.Errorf("empty label")
+		}
+		return nil
+	}
+	parts := strings.SplitN(label, ":", 4)
+	if len(parts) != 4 || parts[0] != "s" && parts[0] != "g" {
+		return fmt.Errorf("label %q is not type:class:name:flavor", label)
+	}
+	if parts[1] != "!" && !contains(classes, parts[1]) {
+		return fmt.Errorf("undeclared class %q", parts[1])
+	}
+	if parts[2] == "" {
+		return fmt.Errorf("empty name in label %q", label)
+	}
+	return nil
+}
+
+// addSig parses sig against the grammar of the entry's section and appends
+// it, raw and parsed, to the entry.
+func addSig(e *Entry, sig string) error {
+	switch e.Section {
+	case "mtu":
+		if n, err := strconv.Atoi(sig); err != nil || n < 1 || n > 65535 {
+			return fmt.Errorf("bad mtu")
+		}
+	case "tcp:request", "tcp:response":
+		s, err := ParseSignature(sig)
+		if err != nil {
+			return err
+		}
+		e.TCP = append(e.TCP, s)
+	default:
+		s, err := ParseHTTPSignature(sig)
+		if err != nil {
+			return err
+		}
+		e.HTTP = append(e.HTTP, s)
+	}
+	e.Sig = append(e.Sig, sig)
+	return nil
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// parseUAOS reads "Name" or "Name=[substring]" items; a bare name is also
+// the User-Agent substring that claims it.
+func parseUAOS(s string) ([]UAMapping, error) {
+	var out []UAMapping
+	for _, v := range splitList(s) {
+		name, match, ok := strings.Cut(v, "=")
+		if !ok {
+			out = append(out, UAMapping{OS: v, Match: v})
+			continue
+		}
+		if !strings.HasPrefix(match, "[") || !strings.HasSuffix(match, "]") || len(match) < 3 {
+			return nil, fmt.Errorf("bad ua_os entry %q", v)
+		}
+		out = append(out, UAMapping{OS: strings.TrimSpace(name), Match: match[1 : len(match)-1]})
+	}
+	return out, nil
+}
+
+// expandSys replaces "@class" references in sys lists with the OS names
+// that the tcp signatures of that class carry, in order of appearance. On
+// error it returns the index of the offending entry.
+func expandSys(db *DB) (int, error) {
+	byClass := make(map[string][]string)
+	for _, e := range db.Entries {
+		if !strings.HasPrefix(e.Section, "tcp:") {
+			continue
+		}
+		_, class, name, _ := e.labelParts()
+		if !contains(byClass[class], name) {
+			byClass[class] = append(byClass[class], name)
+		}
+	}
+	for i := range db.Entries {
+		e := &db.Entries[i]
+		var sys []string
+		for _, v := range e.Sys {
+			class, ok := strings.CutPrefix(v, "@")
+			if !ok {
+				if !contains(sys, v) {
+					sys = append(sys, v)
+				}
+				continue
+			}
+			if !contains(db.Classes, class) {
+				return i, fmt.Errorf("%s: undeclared class %q", e.Label, v)
+			}
+			for _, name := range byClass[class] {
+				if !contains(sys, name) {
+					sys = append(sys, name)
+				}
+			}
+		}
+		e.Sys = sys
+	}
+	return 0, nil
+}
+
+func contains(list []string, v string) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package p0f
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadDBFileMatchesData(t *testing.T) {
+	db, err := LoadDBFile("../p0f.fp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(db, &Data) {
+		t.Fatal("p0f.fp and the generated data.go differ; run go run ./cmd/p0fgen")
+	}
+}
+
+func TestLoadDBErrors(t *testing.T) {
+	cases := []struct {
+		in   string
+		line int
+		msg  string
+	}{
+		{"classes = win,unix\n[tcp:request]\nlabel = s:unix:Linux:x\nsig = *:64:0:*:mss*20,7:mss:df\n", 4, "bad signature"},
+		{"[tcp:request]\nsig = *:64:0:*:mss*20,7:mss:df:0\n", 2, "sig before label"},
+		{"[udp]\n", 1, "unknown section"},
+		{"classes = win\n[tcp:request]\n\n; comment\nlabel = s:unix:Linux:x\n", 5, "undeclared class"},
+		{"[mtu]\nlabel = DSL\nsig = 99999\n", 3, "bad mtu"},
+		{"[mtu]\nlabel = DSL\nbogus\n", 3, "expected key = value"},
+		{"[http:response]\nua_os = Linux\n", 2, "ua_os outside"},
+		{"classes = unix\n[http:request]\nlabel = s:!:curl:\nsys = @win\n", 4, "undeclared class"},
+	}
+	for _, c := range cases {
+		_, err := LoadDB(strings.NewReader(c.in))
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("%q: got %v, want a ParseError", c.in, err)
+			continue
+		}
+		if pe.Line != c.line || !strings.Contains(err.Error(), c.msg) {
+			t.Errorf("%q: got %v, want line %d with %q", c.in, err, c.line, c.msg)
+		}
+	}
+	pe := &ParseError{File: "custom.fp", Line: 7, Err: errors.New("boom")}
+	if pe.Error() != "custom.fp:7: boom" {
+		t.Fatalf("Error() = %q", pe.Error())
+	}
+}
+
+func TestSetDB(t *testing.T) {
+	defer SetDB(&Data)
+	db, err := LoadDB(strings.NewReader("classes = unix\n[mtu]\nlabel = custom\nsig = 1500\n[tcp:request]\nlabel = s:unix:Custom:1\nsig = *:64:0:*:*,*:mss::0\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetDB(db)
+	if r := Detect(PacketMeta{TTL: 60, Win: 1, MSS: 1460, Options: []string{"mss"}}); r.Name != "Custom" {
+		t.Fatalf("Detect = %+v", r)
+	}
+	if l := DetectLink(1460, 4); l != "custom" {
+		t.Fatalf("DetectLink = %q", l)
+	}
+}
+
+func TestLoadDBFilesOverlay(t *testing.T) {
+	overlay := filepath.Join(t.TempDir(), "local.fp")
+	write := func(s string) {
+		if err := os.WriteFile(overlay, []byte(s), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(`[mtu]
+label = Ethernet or modem
+sig = 1500
+label = site VPN
+sig = 1337
+
+[tcp:request]
+label = s:unix:Hardened Linux:1
+sig = *:64:0:*:mss*20,10:mss,sok,ts,nop,ws:df,id+:0
+prio = 1
+disable = s:unix:Linux:3.1-3.10
+`)
+	db, err := LoadDBFiles("../p0f.fp", overlay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDetector(db, DefaultOptions())
+	m := PacketMeta{TTL: 61, Win: 29200, MSS: 1460, WScale: 10, Options: []string{"mss", "sok", "ts", "nop", "ws"}, Quirks: QuirkDF | QuirkNonZeroID}
+	if r := d.Detect(m); r.Name != "Hardened Linux" {
+		t.Fatalf("priority did not win the tie: %+v", r)
+	}
+	if l := d.DetectLink(1297, 4); l != "site VPN" {
+		t.Fatalf("added mtu = %q", l)
+	}
+	if l := d.DetectLink(1460, 4); l != "Ethernet or modem" {
+		t.Fatalf("shadowed mtu = %q", l)
+	}
+	n := 0
+	for _, e := range db.Entries {
+		if e.Label == "Ethernet or modem" {
+			n++
+			if len(e.Sig) != 1 {
+				t.Fatalf("shadowed entry kept stock sigs: %v", e.Sig)
+			}
+		}
+		if e.Label == "s:unix:Linux:3.1-3.10" {
+			t.Fatal("disabled entry still present")
+		}
+	}
+	if n != 1 {
+		t.Fatalf("%d entries for shadowed label", n)
+	}
+
+	write("[tcp:request]\ndisable = s:unix:Nope:1\n")
+	_, err = LoadDBFiles("../p0f.fp", overlay)
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.File != overlay || pe.Line != 2 {
+		t.Fatalf("got %v, want %s:2", err, overlay)
+	}
+}
@@ -1,12 +1,100 @@
 package p0f
 
+import (
+	"sort"
+	"strings"
+)
+
+// Entry is one label of a p0f.fp section. Sys lists the operating systems
+// an application signature runs on, with "@class" references expanded.
+// Entries with a higher Priority are tried first and win score ties.
+//
+// Sig keeps the signatures as written; TCP or HTTP holds them parsed for
+// tcp and http sections, and Generic, Class, Name and Flavor hold the
+// parsed label. Entries built by hand may leave the parsed fields empty.
 type Entry struct {
-	Section string
-	Label   string
-	Sys     string
-	Sig     []string
+	Section  string
+	Label    string
+	Generic  bool
+	Class    string
+	Name     string
+	Flavor   string
+	Sys      []string
+	Sig      []string
+	Priority int
+	TCP      []Signature
+	HTTP     []HTTPSignature
+}
+
+// UAMapping is one ua_os item: the OS a User-Agent claims when it contains
+// Match.
+type UAMapping struct {
+	OS    string
+	Match string
 }
 
 type DB struct {
+	Classes []string
+	UAOS    []UAMapping
 	Entries []Entry
 }
+
+// splitLabel breaks a "type:class:name:flavor" label into its parts.
+func splitLabel(label string) (generic bool, class, name, flavor string) {
+	parts := strings.SplitN(label, ":", 4)
+	if len(parts) != 4 {
+		return false, "", label, ""
+	}
+	return parts[0] == "g", parts[1], parts[2], parts[3]
+}
+
+// labelParts returns the parsed label, splitting Label when the entry does
+// not carry it.
+func (e *Entry) labelParts() (generic bool, class, name, flavor string) {
+	if e.Name != "" {
+		return e.Generic, e.Class, e.Name, e.Flavor
+	}
+	return splitLabel(e.Label)
+}
+
+// tcpSigs returns the parsed tcp signatures, parsing Sig and skipping
+// invalid ones when the entry does not carry them.
+func (e *Entry) tcpSigs() []Signature {
+	if len(e.TCP) == len(e.Sig) {
+		return e.TCP
+	}
+	var out []Signature
+	for _, raw := range e.Sig {
+		if sig, err := ParseSignature(raw); err == nil {
+			out = append(out, sig)
+		}
+	}
+	return out
+}
+
+// httpSigs is tcpSigs for http signatures.
+func (e *Entry) httpSigs() []HTTPSignature {
+	if len(e.HTTP) == len(e.Sig) {
+		return e.HTTP
+	}
+	var out []HTTPSignature
+	for _, raw := range e.Sig {
+		if sig, err := ParseHTTPSignature(raw); err == nil {
+			out = append(out, sig)
+		}
+	}
+	return out
+}
+
+// sectionEntries returns the labelled entries of section ordered by
+// descending priority, keeping file order among equal priorities.
+func sectionEntries(db *DB, section string) []*Entry {
+	var out []*Entry
+	for i := range db.Entries {
+		if e := &db.Entries[i]; e.Section == section && e.Label != "" {
+			out = append(out, e)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Priority > out[j].Priority })
+	return out
+}
@@ -1,55 +1,323 @@
 package p0f
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"net"
+	"strconv"
+)
+
+const (
+	TCPFin uint8 = 1 << iota
+	TCPSyn
+	TCPRst
+	TCPPush
+	TCPAck
+	TCPUrg
+	TCPEce
+	TCPCwr
+)
 
 type PacketMeta struct {
-	TTL     int
-	Win     uint16
-	MSS     uint16
-	WScale  int
-	Options []string
-	ECN     bool
+	Version  int
+	TTL      int
+	Win      uint16
+	MSS      uint16
+	WScale   int
+	Options  []string
+	ECN      bool
+	IPID     uint16
+	Flow     uint32
+	DF       bool
+	Reserved bool
+	Seq      uint32
+	Ack      uint32
+	Flags    uint8
+	Urgent   uint16
+	TS1      uint32
+	TS2      uint32
+	Quirks   Quirks
+	// IPOptLen is the length of the IPv4 options, the olen field of a
+	// signature. As in p0f it is 0 for IPv6, whose extension headers are
+	// skipped rather than counted.
+	IPOptLen int
+	// PayloadLen is the TCP payload length the IP header announces, which
+	// may exceed what was captured; a SYN carrying data (TCP Fast Open)
+	// has payload class "+" instead of 0.
+	PayloadLen int
+}
+
+// Packet is an IP/TCP header pair decoded by ParseIP.
+type Packet struct {
+	Meta    PacketMeta
+	SrcIP   net.IP
+	DstIP   net.IP
+	SrcPort int
+	DstPort int
+	// Payload is the captured part of the TCP payload.
+	Payload []byte
+}
+
+// ServicePort is the port of the side that accepts the connection: the
+// destination port of a SYN, the source port of a SYN+ACK.
+func (p *Packet) ServicePort() int {
+	if p.Meta.Flags&(TCPSyn|TCPAck) == TCPSyn|TCPAck {
+		return p.SrcPort
+	}
+	return p.DstPort
+}
+
+// ClientPort is the port of the side that opens the connection, the other
+// end of ServicePort.
+func (p *Packet) ClientPort() int {
+	if p.Meta.Flags&(TCPSyn|TCPAck) == TCPSyn|TCPAck {
+		return p.DstPort
+	}
+	return p.SrcPort
+}
+
+// ParseIP decodes an IPv4 or IPv6 packet carrying TCP, starting at the IP
+// header. Only the headers need to be present; the payload may be truncated.
+func ParseIP(b []byte) (Packet, bool) {
+	if len(b) > 0 && b[0]>>4 == 6 {
+		return parseIPv6(b)
+	}
+	return parseIPv4(b)
+}
+
+func parseIPv4(b []byte) (Packet, bool) {
+	var p Packet
+	if len(b) < 20 || b[0]>>4 != 4 {
+		return p, false
+	}
+	ihl := int(b[0]&0x0f) * 4
+	if ihl < 20 || len(b) < ihl || b[9] != 6 {
+		return p, false
+	}
+	// drop link-layer padding past the IP total length; a zero total
+	// length (TSO) leaves the captured length
+	tl := int(binary.BigEndian.Uint16(b[2:4]))
+	if tl < ihl {
+		tl = len(b)
+	} else if tl < len(b) {
+		b = b[:tl]
+	}
+	m := &p.Meta
+	m.Version = 4
+	m.IPOptLen = ihl - 20
+	m.TTL = int(b[8])
+	m.IPID = binary.BigEndian.Uint16(b[4:6])
+	m.DF = b[6]&0x40 != 0
+	m.Reserved = b[6]&0x80 != 0
+	m.ECN = b[1]&0x03 != 0
+	if m.DF {
+		m.Quirks |= QuirkDF
+		if m.IPID != 0 {
+			m.Quirks |= QuirkNonZeroID
+		}
+	} else if m.IPID == 0 {
+		m.Quirks |= QuirkZeroID
+	}
+	if m.Reserved {
+		m.Quirks |= QuirkNonZeroReserved
+	}
+	p.SrcIP = net.IP(b[12:16])
+	p.DstIP = net.IP(b[16:20])
+	if !parseTCP(b[ihl:], tl-ihl, &p) {
+		return p, false
+	}
+	return p, true
 }
 
+func parseIPv6(b []byte) (Packet, bool) {
+	var p Packet
+	if len(b) < 40 {
+		return p, false
+	}
+	// a zero payload length (jumbogram) leaves the captured length
+	pl := 40 + int(binary.BigEndian.Uint16(b[4:6]))
+	if pl == 40 {
+		pl = len(b)
+	} else if pl < len(b) {
+		b = b[:pl]
+	}
+	m := &p.Meta
+	m.Version = 6
+	m.TTL = int(b[7])
+	m.ECN = (b[1]>>4)&0x03 != 0
+	m.Flow = binary.BigEndian.Uint32(b[0:4]) & 0xfffff
+	if m.Flow != 0 {
+		m.Quirks |= QuirkFlow
+	}
+	p.SrcIP = net.IP(b[8:24])
+	p.DstIP = net.IP(b[24:40])
+	next := b[6]
+	off := 40
+	for next != 6 {
+		if len(b) < off+8 {
+			return p, false
+		}
+		var l int
+		switch next {
+		case 0, 43, 60:
+			l = (int(b[off+1]) + 1) * 8
+		case 44:
+			if binary.BigEndian.Uint16(b[off+2:off+4])&0xfff8 != 0 {
+				return p, false
+			}
+			l = 8
+		case 51:
+			l = (int(b[off+1]) + 2) * 4
+		default:
+			return p, false
+		}
+		next = b[off]
+		off += l
+	}
+	if len(b) < off {
+		return p, false
+	}
+	if !parseTCP(b[off:], pl-off, &p) {
+		return p, false
+	}
+	return p, true
+}
+
+// parseTCP decodes the TCP header in tcp, the captured part of a segLen
+// byte segment.
+func parseTCP(tcp []byte, segLen int, p *Packet) bool {
+	if len(tcp) < 20 {
+		return false
+	}
+	off := int(tcp[12]>>4) * 4
+	if off < 20 || len(tcp) < off {
+		return false
+	}
+	m := &p.Meta
+	p.SrcPort = int(binary.BigEndian.Uint16(tcp[0:2]))
+	p.DstPort = int(binary.BigEndian.Uint16(tcp[2:4]))
+	m.Seq = binary.BigEndian.Uint32(tcp[4:8])
+	m.Ack = binary.BigEndian.Uint32(tcp[8:12])
+	m.Flags = tcp[13]
+	m.Win = binary.BigEndian.Uint16(tcp[14:16])
+	m.Urgent = binary.BigEndian.Uint16(tcp[18:20])
+	if m.Flags&(TCPEce|TCPCwr) != 0 {
+		m.ECN = true
+	}
+	if m.ECN {
+		m.Quirks |= QuirkECN
+	}
+	if m.Seq == 0 {
+		m.Quirks |= QuirkZeroSeq
+	}
+	if m.Flags&TCPAck != 0 {
+		if m.Ack == 0 {
+			m.Quirks |= QuirkZeroAck
+		}
+	} else if m.Ack != 0 && m.Flags&TCPRst == 0 {
+		m.Quirks |= QuirkNonZeroAck
+	}
+	if m.Flags&TCPUrg != 0 {
+		m.Quirks |= QuirkURG
+	} else if m.Urgent != 0 {
+		m.Quirks |= QuirkNonZeroURG
+	}
+	if m.Flags&TCPPush != 0 {
+		m.Quirks |= QuirkPush
+	}
+	parseOptions(tcp[20:off], m)
+	p.Payload = tcp[off:]
+	m.PayloadLen = max(segLen-off, len(p.Payload))
+	return true
+}
+
+// ParseTCPOptions returns the ordered p0f option layout, with "eol+N" for an
+// end-of-list followed by N padding bytes and "?N" for unknown kind N.
 func ParseTCPOptions(b []byte) (opts []string, mss uint16, wscale int) {
+	var m PacketMeta
+	parseOptions(b, &m)
+	return m.Options, m.MSS, m.WScale
+}
+
+// parseOptions fills the option layout, MSS, window scale and timestamps of
+// m and records the option-related quirks.
+func parseOptions(b []byte, m *PacketMeta) {
 	i := 0
-	wscale = 0
+	m.WScale = 0
+	hasTS := false
 	for i < len(b) {
 		o := b[i]
 		if o == 0 {
+			m.Options = append(m.Options, "eol+"+strconv.Itoa(len(b)-i-1))
+			for _, c := range b[i+1:] {
+				if c != 0 {
+					m.Quirks |= QuirkOptTrailing
+					break
+				}
+			}
 			break
 		}
 		if o == 1 {
-			opts = append(opts, "nop")
+			m.Options = append(m.Options, "nop")
 			i++
 			continue
 		}
 		if i+1 >= len(b) {
+			m.Quirks |= QuirkBadOpt
 			break
 		}
 		l := int(b[i+1])
 		if l < 2 || i+l > len(b) {
+			m.Quirks |= QuirkBadOpt
 			break
 		}
 		switch o {
 		case 2:
 			if l == 4 {
-				mss = binary.BigEndian.Uint16(b[i+2 : i+4])
-				opts = append(opts, "mss")
+				m.MSS = binary.BigEndian.Uint16(b[i+2 : i+4])
+			} else {
+				m.Quirks |= QuirkBadOpt
 			}
+			m.Options = append(m.Options, "mss")
 		case 3:
 			if l == 3 {
-				wscale = int(b[i+2])
+				m.WScale = int(b[i+2])
+				if m.WScale > 14 {
+					m.Quirks |= QuirkExcessWS
+				}
+			} else {
+				m.Quirks |= QuirkBadOpt
 			}
-			opts = append(opts, "ws")
+			m.Options = append(m.Options, "ws")
 		case 4:
-			opts = append(opts, "sok")
+			if l != 2 {
+				m.Quirks |= QuirkBadOpt
+			}
+			m.Options = append(m.Options, "sok")
+		case 5:
+			if l < 10 || l > 34 {
+				m.Quirks |= QuirkBadOpt
+			}
+			m.Options = append(m.Options, "sack")
 		case 8:
 			if l == 10 {
-				opts = append(opts, "ts")
+				hasTS = true
+				m.TS1 = binary.BigEndian.Uint32(b[i+2 : i+6])
+				m.TS2 = binary.BigEndian.Uint32(b[i+6 : i+10])
+			} else {
+				m.Quirks |= QuirkBadOpt
 			}
+			m.Options = append(m.Options, "ts")
+		default:
+			m.Options = append(m.Options, "?"+strconv.Itoa(int(o)))
 		}
 		i += l
 	}
-	return
+	if hasTS {
+		if m.TS1 == 0 {
+			m.Quirks |= QuirkZeroTS1
+		}
+		if m.TS2 != 0 && m.Flags&(TCPSyn|TCPAck) == TCPSyn {
+			m.Quirks |= QuirkNonZeroTS2
+		}
+	}
 }
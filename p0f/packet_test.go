@@ -0,0 +1,182 @@
+package p0f
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func synPacket(opts []byte) []byte {
+	b := make([]byte, 40+len(opts))
+	b[0] = 0x45
+	binary.BigEndian.PutUint16(b[2:4], uint16(len(b)))
+	binary.BigEndian.PutUint16(b[4:6], 0x1234)
+	b[6] = 0x40
+	b[8] = 61
+	b[9] = 6
+	copy(b[12:16], []byte{10, 0, 0, 1})
+	copy(b[16:20], []byte{10, 0, 0, 2})
+	tcp := b[20:]
+	binary.BigEndian.PutUint16(tcp[0:2], 40000)
+	binary.BigEndian.PutUint16(tcp[2:4], 443)
+	binary.BigEndian.PutUint32(tcp[4:8], 0xdeadbeef)
+	tcp[12] = byte((20 + len(opts)) / 4 << 4)
+	tcp[13] = TCPSyn
+	binary.BigEndian.PutUint16(tcp[14:16], 29200)
+	copy(tcp[20:], opts)
+	return b
+}
+
+var linuxOpts = []byte{
+	2, 4, 0x05, 0xb4,
+	4, 2,
+	8, 10, 0, 0, 0, 1, 0, 0, 0, 0,
+	1,
+	3, 3, 7,
+}
+
+func TestParseIP(t *testing.T) {
+	p, ok := ParseIP(synPacket(linuxOpts))
+	if !ok {
+		t.Fatal("parse failed")
+	}
+	m := p.Meta
+	if p.SrcIP.String() != "10.0.0.1" || p.DstPort != 443 || m.TTL != 61 || m.Win != 29200 || m.MSS != 1460 || m.WScale != 7 || m.TS1 != 1 {
+		t.Fatalf("bad packet %+v", p)
+	}
+	if m.Quirks != QuirkDF|QuirkNonZeroID {
+		t.Fatalf("quirks = %q", m.Quirks)
+	}
+	if r := Detect(m); r.Label != "s:unix:Linux:3.11 and newer" || r.Fuzzy {
+		t.Fatalf("detect = %+v", r)
+	}
+}
+
+func TestParseIPPayload(t *testing.T) {
+	b := append(synPacket(nil), "GET / HTTP/1.1\r\n"...)
+	binary.BigEndian.PutUint16(b[2:4], uint16(len(b)))
+	b = append(b, 0, 0, 0, 0) // ethernet padding
+	p, ok := ParseIP(b)
+	if !ok || string(p.Payload) != "GET / HTTP/1.1\r\n" {
+		t.Fatalf("payload = %q, %v", p.Payload, ok)
+	}
+	if p.Meta.PayloadLen != 16 || p.Meta.IPOptLen != 0 {
+		t.Fatalf("payload len = %d, olen = %d", p.Meta.PayloadLen, p.Meta.IPOptLen)
+	}
+	// A truncated capture still reports the payload the header announces.
+	if p, ok := ParseIP(b[:45]); !ok || p.Meta.PayloadLen != 16 || len(p.Payload) != 5 {
+		t.Fatalf("truncated: payload len = %d, captured %q", p.Meta.PayloadLen, p.Payload)
+	}
+	if r := Detect(p.Meta); r.Label != "Unknown" {
+		t.Fatalf("SYN with data matched %+v", r)
+	}
+}
+
+func TestParseIPOptions(t *testing.T) {
+	syn := synPacket(linuxOpts)
+	// Insert a 4-byte router alert option after the fixed header.
+	b := append(append(append([]byte{}, syn[:20]...), 0x94, 0x04, 0, 0), syn[20:]...)
+	b[0] = 0x46
+	binary.BigEndian.PutUint16(b[2:4], uint16(len(b)))
+	p, ok := ParseIP(b)
+	if !ok || p.Meta.IPOptLen != 4 || p.Meta.MSS != 1460 || p.Meta.PayloadLen != 0 {
+		t.Fatalf("bad packet %+v, %v", p.Meta, ok)
+	}
+	if r := Detect(p.Meta); r.Label != "Unknown" {
+		t.Fatalf("SYN with IP options matched %+v", r)
+	}
+}
+
+func TestParseIPQuirks(t *testing.T) {
+	opts := []byte{
+		2, 4, 0x05, 0xb4,
+		8, 10, 0, 0, 0, 0, 0, 0, 0, 9,
+		3, 3, 15,
+		0, 0, 7,
+	}
+	b := synPacket(opts)
+	b[6] = 0x80
+	binary.BigEndian.PutUint16(b[4:6], 0)
+	tcp := b[20:]
+	binary.BigEndian.PutUint32(tcp[4:8], 0)
+	binary.BigEndian.PutUint32(tcp[8:12], 5)
+	tcp[13] |= TCPPush | TCPEce
+	binary.BigEndian.PutUint16(tcp[18:20], 1)
+	p, ok := ParseIP(b)
+	if !ok {
+		t.Fatal("parse failed")
+	}
+	want := "id-,ecn,0+,seq-,ack+,uptr+,pushf+,ts1-,ts2+,opt+,exws"
+	if got := p.Meta.Quirks.String(); got != want {
+		t.Fatalf("quirks = %q, want %q", got, want)
+	}
+	if _, ok := ParseIP(b[:30]); ok {
+		t.Fatal("truncated packet should not parse")
+	}
+}
+
+func TestParseTCPOptionsLayout(t *testing.T) {
+	opts := []byte{
+		2, 4, 0x05, 0xb4,
+		1,
+		3, 3, 5,
+		1, 1,
+		8, 10, 0, 0, 0, 1, 0, 0, 0, 0,
+		4, 2,
+		30, 3, 0,
+		0, 0,
+	}
+	layout, mss, ws := ParseTCPOptions(opts)
+	want := []string{"mss", "nop", "ws", "nop", "nop", "ts", "sok", "?30", "eol+1"}
+	if !sameLayout(layout, want) || mss != 1460 || ws != 5 {
+		t.Fatalf("got %v mss=%d ws=%d, want %v", layout, mss, ws, want)
+	}
+}
+
+func TestParseIPv6(t *testing.T) {
+	v4 := synPacket(linuxOpts)
+	tcp := v4[20:]
+	b := make([]byte, 48+len(tcp))
+	b[0] = 0x60
+	binary.BigEndian.PutUint16(b[4:6], uint16(8+len(tcp)))
+	b[6] = 0
+	b[7] = 57
+	b[8], b[23] = 0x20, 1
+	b[24], b[39] = 0x20, 2
+	b[40] = 6
+	copy(b[48:], tcp)
+	p, ok := ParseIP(b)
+	if !ok {
+		t.Fatal("parse failed")
+	}
+	m := p.Meta
+	if m.Version != 6 || m.TTL != 57 || p.DstPort != 443 || m.MSS != 1460 || m.Quirks != 0 {
+		t.Fatalf("bad packet %+v", p)
+	}
+	if r := Detect(m); r.Label != "s:unix:Linux:3.11 and newer" || r.Fuzzy {
+		t.Fatalf("detect = %+v", r)
+	}
+	b[1], b[2], b[3] = 0x01, 0x23, 0x45
+	if p, _ := ParseIP(b); p.Meta.Flow != 0x12345 || p.Meta.Quirks != QuirkFlow {
+		t.Fatalf("flow label not reported: %+v", p.Meta)
+	}
+	b[40] = 17
+	if _, ok := ParseIP(b); ok {
+		t.Fatal("non-tcp next header should not parse")
+	}
+}
+
+func TestPacketPorts(t *testing.T) {
+	b := synPacket(linuxOpts)
+	p, _ := ParseIP(b)
+	if p.ServicePort() != 443 || p.ClientPort() != 40000 {
+		t.Fatalf("SYN: service %d, client %d", p.ServicePort(), p.ClientPort())
+	}
+	// The server answers from the service port.
+	tcp := b[20:]
+	tcp[0], tcp[1], tcp[2], tcp[3] = tcp[2], tcp[3], tcp[0], tcp[1]
+	tcp[13] = TCPSyn | TCPAck
+	p, _ = ParseIP(b)
+	if p.ServicePort() != 443 || p.ClientPort() != 40000 {
+		t.Fatalf("SYN+ACK: service %d, client %d", p.ServicePort(), p.ClientPort())
+	}
+}
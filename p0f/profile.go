@@ -0,0 +1,137 @@
+package p0f
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// StrictProfile accepts only signatures that match a packet exactly, as
+// p0f does without fuzzy matching; the weights then only rank exact
+// matches against each other.
+var StrictProfile = ScoringProfile{
+	Quirks:      2,
+	TTL:         3,
+	WinAny:      2,
+	WinExact:    3,
+	WinScale:    2,
+	LayoutExact: 6,
+	Exact:       true,
+}
+
+// Profiles are the shipped presets by name: "fuzzy" is DefaultProfile and
+// "strict" is StrictProfile. A profile file can start from either with
+// "base = name".
+var Profiles = map[string]ScoringProfile{
+	"fuzzy":  DefaultProfile,
+	"strict": StrictProfile,
+}
+
+// profileKeys maps the keys of a profile file to the weights they set.
+var profileKeys = map[string]func(*ScoringProfile) *float64{
+	"quirks":         func(p *ScoringProfile) *float64 { return &p.Quirks },
+	"ttl":            func(p *ScoringProfile) *float64 { return &p.TTL },
+	"win_any":        func(p *ScoringProfile) *float64 { return &p.WinAny },
+	"win_exact":      func(p *ScoringProfile) *float64 { return &p.WinExact },
+	"win_scale":      func(p *ScoringProfile) *float64 { return &p.WinScale },
+	"win_near":       func(p *ScoringProfile) *float64 { return &p.WinNear },
+	"win_tolerance":  func(p *ScoringProfile) *float64 { return &p.WinTolerance },
+	"layout_exact":   func(p *ScoringProfile) *float64 { return &p.LayoutExact },
+	"layout_overlap": func(p *ScoringProfile) *float64 { return &p.LayoutOverlap },
+	"layout_similar": func(p *ScoringProfile) *float64 { return &p.LayoutSimilar },
+}
+
+// LoadProfile returns the preset called name, or else reads name as a
+// profile file.
+func LoadProfile(name string) (ScoringProfile, error) {
+	if p, ok := Profiles[name]; ok {
+		return p, nil
+	}
+	return LoadProfileFile(name)
+}
+
+// LoadProfileFile reads a scoring profile from path.
+func LoadProfileFile(path string) (ScoringProfile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return ScoringProfile{}, err
+	}
+	defer f.Close()
+	return parseProfile(f, path)
+}
+
+// ParseProfile reads a scoring profile in the p0f.fp "key = value" syntax
+// with ";" comments. The profile starts as DefaultProfile, or as the preset
+// named by a leading "base = name"; "exact = true|false" and the weights
+// quirks, ttl, win_any, win_exact, win_scale, win_near, win_tolerance,
+// layout_exact, layout_overlap and layout_similar override it. Errors are
+// *ParseError values carrying the line.
+func ParseProfile(r io.Reader) (ScoringProfile, error) {
+	return parseProfile(r, "")
+}
+
+func parseProfile(r io.Reader, file string) (ScoringProfile, error) {
+	p := DefaultProfile
+	set := false
+	lineNo := 0
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		lineNo++
+		t := strings.TrimSpace(sc.Text())
+		if t == "" || strings.HasPrefix(t, ";") {
+			continue
+		}
+		if err := profileLine(&p, t, set); err != nil {
+			return ScoringProfile{}, &ParseError{File: file, Line: lineNo, Err: err}
+		}
+		set = true
+	}
+	if err := sc.Err(); err != nil {
+		return ScoringProfile{}, err
+	}
+	return p, nil
+}
+
+func profileLine(p *ScoringProfile, t string, set bool) error {
+	key, val, ok := strings.Cut(t, "=")
+	if !ok {
+		return fmt.Errorf("expected key = value, got %q", t)
+	}
+	key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+	switch key {
+	case "base":
+		if set {
+			return fmt.Errorf("base after other keys")
+		}
+		base, ok := Profiles[val]
+		if !ok {
+			return fmt.Errorf("unknown profile %q", val)
+		}
+		*p = base
+		return nil
+	case "exact":
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return fmt.Errorf("bad exact %q", val)
+		}
+		p.Exact = b
+		return nil
+	}
+	field, ok := profileKeys[key]
+	if !ok {
+		return fmt.Errorf("unknown key %q", key)
+	}
+	v, err := strconv.ParseFloat(val, 64)
+	if err != nil || !(v >= 0) || math.IsInf(v, 1) {
+		return fmt.Errorf("bad %s %q", key, val)
+	}
+	if key == "win_tolerance" && v >= 1 {
+		return fmt.Errorf("win_tolerance %q is not below 1", val)
+	}
+	*field(p) = v
+	return nil
+}
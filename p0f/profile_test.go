@@ -0,0 +1,80 @@
+package p0f
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseProfile(t *testing.T) {
+	p, err := ParseProfile(strings.NewReader("; tuned\nbase = strict\nttl = 5\nwin_tolerance = 0.05\nexact = false\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := StrictProfile
+	want.TTL, want.WinTolerance, want.Exact = 5, 0.05, false
+	if p != want {
+		t.Fatalf("profile = %+v, want %+v", p, want)
+	}
+	if p, err := ParseProfile(strings.NewReader("")); err != nil || p != DefaultProfile {
+		t.Fatalf("empty profile = %+v, %v", p, err)
+	}
+	for _, bad := range []string{"ttl = -1", "ttl = NaN", "win_tolerance = 1", "exact = maybe", "colour = 3", "ttl 3", "ttl = 3\nbase = fuzzy", "base = loose"} {
+		_, err := ParseProfile(strings.NewReader(bad))
+		var pe *ParseError
+		if !errors.As(err, &pe) || pe.Line != strings.Count(bad, "\n")+1 {
+			t.Errorf("%q: err = %v", bad, err)
+		}
+	}
+	if p, err := LoadProfile("strict"); err != nil || !p.Exact {
+		t.Fatalf("LoadProfile(strict) = %+v, %v", p, err)
+	}
+}
+
+func TestStrictProfile(t *testing.T) {
+	strict := NewDetector(&Data, Options{Profile: &StrictProfile, Fuzzy: true})
+	linux := PacketMeta{Version: 4, TTL: 61, Win: 29200, MSS: 1460, WScale: 7, Options: []string{"mss", "sok", "ts", "nop", "ws"}, Quirks: QuirkDF | QuirkNonZeroID}
+	if r := strict.Detect(linux); r.Name != "Linux" || r.Fuzzy {
+		t.Fatalf("exact packet = %+v", r)
+	}
+	// A window 5% off, a lost df and a reordered layout no longer match
+	// the specific Linux signature exactly.
+	near := linux
+	near.Win = 29200 * 105 / 100
+	noDF := linux
+	noDF.Quirks = QuirkNonZeroID
+	reordered := linux
+	reordered.Options = []string{"mss", "nop", "ws", "sok", "ts"}
+	for _, m := range []PacketMeta{near, noDF, reordered} {
+		if r := Detect(m); r.Label == "Unknown" {
+			t.Fatalf("fuzzy profile on %+v = %+v", m, r)
+		}
+		// Only a wildcard signature such as the generic Linux one can
+		// still match exactly.
+		if r := strict.Detect(m); r.Fuzzy || r.Label != "Unknown" && !strings.Contains(r.Sig, "*,*") {
+			t.Fatalf("strict profile on %+v = %+v", m, r)
+		}
+	}
+	if r := strict.Detect(reordered); r.Label != "Unknown" {
+		t.Fatalf("strict profile on another layout = %+v", r)
+	}
+
+	p := DefaultProfile
+	p.WinTolerance = 0.01
+	tight := NewDetector(&Data, Options{Profile: &p, Fuzzy: true})
+	window := func(d *Detector) float64 {
+		for _, c := range d.Explain(near) {
+			if c.Label == "s:unix:Linux:3.11 and newer" {
+				return c.Fields[3].Score
+			}
+		}
+		t.Fatal("s:unix:Linux:3.11 and newer not among the candidates")
+		return 0
+	}
+	if got := window(Default()); got != DefaultProfile.WinNear {
+		t.Fatalf("window points with 15%% tolerance = %v", got)
+	}
+	if got := window(tight); got != 0 {
+		t.Fatalf("window points with 1%% tolerance = %v", got)
+	}
+}
@@ -0,0 +1,97 @@
+package p0f
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RawSig renders the packet as a p0f raw_sig, in the tcp:request and
+// tcp:response signature format, so an unknown SYN or SYN+ACK can be pasted
+// into p0f.fp. The initial TTL is written as the observed TTL plus the
+// guessed distance.
+func (m PacketMeta) RawSig() string {
+	ver := "*"
+	if m.Version == 4 || m.Version == 6 {
+		ver = fmt.Sprint(m.Version)
+	}
+	mss := "*"
+	if m.MSS != 0 {
+		mss = fmt.Sprint(m.MSS)
+	}
+	return fmt.Sprintf("%s:%d+%d:%d:%s:%s,%d:%s:%s:%s",
+		ver, m.TTL, guessDist(m.TTL), m.IPOptLen, mss, rawWin(m), m.WScale,
+		strings.Join(m.Options, ","), m.Quirks, pclass(m.PayloadLen))
+}
+
+// pclass renders the payload class of a payload of n bytes.
+func pclass(n int) string {
+	if n > 0 {
+		return "+"
+	}
+	return "0"
+}
+
+// rawWin expresses the window as a multiple of the MSS or MTU when it is
+// one, as p0f does.
+func rawWin(m PacketMeta) string {
+	win, mss := int(m.Win), int(m.MSS)
+	if win != 0 && mss != 0 {
+		if win%mss == 0 {
+			return fmt.Sprintf("mss*%d", win/mss)
+		}
+		if mtu := mss + mtuHeader(m.Version); win%mtu == 0 {
+			return fmt.Sprintf("mtu*%d", win/mtu)
+		}
+	}
+	return fmt.Sprint(win)
+}
+
+// Header lists used by HTTPMeta.RawSig, after p0f: optional headers are
+// marked "?" without a value, skipval headers are written without their
+// value, and common headers that are missing go into habsent.
+var (
+	httpReqOptional  = []string{"Cookie", "Referer", "Origin", "Range", "If-Modified-Since", "If-None-Match", "Via", "X-Forwarded-For", "Authorization", "Proxy-Authorization", "Cache-Control"}
+	httpRespOptional = []string{"Set-Cookie", "Last-Modified", "ETag", "Content-Length", "Content-Disposition", "Cache-Control", "Expires", "Pragma", "Location", "Refresh", "Content-Range", "Vary"}
+	httpReqSkipVal   = []string{"Host", "User-Agent"}
+	httpRespSkipVal  = []string{"Date", "Content-Type", "Server"}
+	httpReqCommon    = []string{"Accept", "Accept-Encoding", "Accept-Language", "Accept-Charset", "Connection", "Keep-Alive"}
+	httpRespCommon   = []string{"Content-Type", "Connection", "Keep-Alive", "Accept-Ranges", "Date"}
+)
+
+// RawSig renders the message as a p0f raw_sig in the http:request or
+// http:response signature format, with the User-Agent or Server header as
+// the expected software.
+func (h *HTTPMeta) RawSig() string {
+	optional, skipVal, common, sw := httpReqOptional, httpReqSkipVal, httpReqCommon, "User-Agent"
+	if h.Response {
+		optional, skipVal, common, sw = httpRespOptional, httpRespSkipVal, httpRespCommon, "Server"
+	}
+	var order []string
+	for _, hd := range h.Headers {
+		switch {
+		case containsFold(optional, hd.Name):
+			order = append(order, "?"+hd.Name)
+		case containsFold(skipVal, hd.Name) || strings.ContainsAny(hd.Value, "[]"):
+			order = append(order, hd.Name)
+		default:
+			order = append(order, hd.Name+"=["+hd.Value+"]")
+		}
+	}
+	var absent []string
+	for _, name := range common {
+		if _, ok := h.Header(name); !ok {
+			absent = append(absent, name)
+		}
+	}
+	software, _ := h.Header(sw)
+	return fmt.Sprintf("%d:%s:%s:%s", h.Version, strings.Join(order, ","), strings.Join(absent, ","), software)
+}
+
+func containsFold(list []string, v string) bool {
+	for _, x := range list {
+		if strings.EqualFold(x, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+package p0f
+
+import "testing"
+
+func TestPacketRawSig(t *testing.T) {
+	m := PacketMeta{Version: 4, TTL: 61, Win: 29200, MSS: 1460, WScale: 7, Options: []string{"mss", "sok", "ts", "nop", "ws"}, Quirks: QuirkDF | QuirkNonZeroID}
+	raw := m.RawSig()
+	if raw != "4:61+3:0:1460:mss*20,7:mss,sok,ts,nop,ws:df,id+:0" {
+		t.Fatalf("RawSig = %q", raw)
+	}
+	tfo := m
+	tfo.IPOptLen, tfo.PayloadLen = 8, 100
+	if raw := tfo.RawSig(); raw != "4:61+3:8:1460:mss*20,7:mss,sok,ts,nop,ws:df,id+:+" {
+		t.Fatalf("RawSig with options and data = %q", raw)
+	}
+	cases := []PacketMeta{
+		m,
+		{TTL: 113, Win: 8192, WScale: 8, Options: []string{"nop", "ws", "sok"}, Quirks: QuirkNonZeroID},
+		{Version: 6, TTL: 50, Win: 4 * (1440 + 60), MSS: 1440, Options: []string{"mss"}, Quirks: QuirkFlow},
+		{Version: 4, TTL: 64, Win: 65535, MSS: 1460, Options: []string{"mss"}, IPOptLen: 4, PayloadLen: 120},
+	}
+	for _, c := range cases {
+		sig, err := ParseSignature(c.RawSig())
+		if err != nil {
+			t.Fatalf("%q: %v", c.RawSig(), err)
+		}
+		if _, ok := fieldScore(&sig, c, &DefaultProfile); !ok || !exactMatch(&sig, c) {
+			t.Fatalf("%q does not match the packet it was rendered from", c.RawSig())
+		}
+	}
+}
+
+func TestHTTPRawSig(t *testing.T) {
+	for _, in := range []string{chromeRequest, nginxResponse} {
+		h, ok := ParseHTTP([]byte(in))
+		if !ok {
+			t.Fatal("parse failed")
+		}
+		raw := h.RawSig()
+		sig, err := ParseHTTPSignature(raw)
+		if err != nil {
+			t.Fatalf("%q: %v", raw, err)
+		}
+		if !httpLayoutMatch(&sig, h) {
+			t.Fatalf("%q does not match the message it was rendered from", raw)
+		}
+		if h.Response && sig.Software != "nginx/1.18.0" {
+			t.Fatalf("%q: software %q", raw, sig.Software)
+		}
+	}
+}
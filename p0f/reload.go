@@ -0,0 +1,92 @@
+package p0f
+
+import (
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Reloader keeps the default Detector in sync with one or more p0f.fp files
+// merged in order. A set of files that fails to load leaves the previous
+// database in place.
+type Reloader struct {
+	paths []string
+	// OnReload, if set, is called after every reload attempt with its
+	// outcome.
+	OnReload func(error)
+
+	mu        sync.Mutex
+	stats     []fileStat
+	successes atomic.Int64
+	failures  atomic.Int64
+}
+
+type fileStat struct {
+	size    int64
+	modTime time.Time
+}
+
+func NewReloader(paths ...string) *Reloader {
+	return &Reloader{paths: paths}
+}
+
+// Reload parses the files and, if they are valid, makes them the database
+// of the default Detector.
+func (r *Reloader) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stats = r.stat()
+	db, err := LoadDBFiles(r.paths...)
+	if err != nil {
+		r.failures.Add(1)
+	} else {
+		SetDB(db)
+		r.successes.Add(1)
+	}
+	if r.OnReload != nil {
+		r.OnReload(err)
+	}
+	return err
+}
+
+// Counts returns the number of successful and failed reloads so far.
+func (r *Reloader) Counts() (successes, failures int64) {
+	return r.successes.Load(), r.failures.Load()
+}
+
+func (r *Reloader) stat() []fileStat {
+	stats := make([]fileStat, len(r.paths))
+	for i, p := range r.paths {
+		if fi, err := os.Stat(p); err == nil {
+			stats[i] = fileStat{fi.Size(), fi.ModTime()}
+		}
+	}
+	return stats
+}
+
+// Watch polls the files every interval and reloads them when the size or
+// modification time of any of them changes, until stop is closed.
+func (r *Reloader) Watch(interval time.Duration, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+		stats := r.stat()
+		r.mu.Lock()
+		changed := false
+		for i, st := range stats {
+			if i >= len(r.stats) || st.size != r.stats[i].size || !st.modTime.Equal(r.stats[i].modTime) {
+				changed = true
+			}
+		}
+		r.mu.Unlock()
+		if changed {
+			_ = r.Reload()
+		}
+	}
+}
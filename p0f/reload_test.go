@@ -0,0 +1,59 @@
+package p0f
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const reloadDB = "classes = unix\n[tcp:request]\nlabel = s:unix:%s:1\nsig = *:64:0:*:*,*:mss::0\n"
+
+func TestReloader(t *testing.T) {
+	defer SetDB(&Data)
+	path := filepath.Join(t.TempDir(), "p0f.fp")
+	write := func(s string) {
+		if err := os.WriteFile(path, []byte(s), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m := PacketMeta{TTL: 60, Win: 1, MSS: 1460, Options: []string{"mss"}}
+	write(fmt.Sprintf(reloadDB, "First"))
+	r := NewReloader(path)
+	if err := r.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if got := Detect(m).Name; got != "First" {
+		t.Fatalf("Detect = %q", got)
+	}
+
+	write("[tcp:request]\nlabel = broken\n")
+	if err := r.Reload(); err == nil {
+		t.Fatal("expected reload failure")
+	}
+	if got := Detect(m).Name; got != "First" {
+		t.Fatalf("failed reload replaced the database: %q", got)
+	}
+	if ok, failed := r.Counts(); ok != 1 || failed != 1 {
+		t.Fatalf("Counts = %d, %d", ok, failed)
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	reloaded := make(chan error, 4)
+	r.OnReload = func(err error) { reloaded <- err }
+	go r.Watch(10*time.Millisecond, stop)
+	write(fmt.Sprintf(reloadDB, "Second one"))
+	select {
+	case err := <-reloaded:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("watch did not pick up the change")
+	}
+	if got := Detect(m).Name; got != "Second one" {
+		t.Fatalf("Detect after watch = %q", got)
+	}
+}
@@ -0,0 +1,273 @@
+package p0f
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Any marks a wildcard ("*") numeric field in a Signature.
+const Any = -1
+
+type WinType int
+
+const (
+	WinAny WinType = iota
+	WinValue
+	WinMSS
+	WinMTU
+	WinMod
+)
+
+type Quirks uint32
+
+const (
+	QuirkDF Quirks = 1 << iota
+	QuirkNonZeroID
+	QuirkZeroID
+	QuirkECN
+	QuirkNonZeroReserved
+	QuirkFlow
+	QuirkZeroSeq
+	QuirkNonZeroAck
+	QuirkZeroAck
+	QuirkNonZeroURG
+	QuirkURG
+	QuirkPush
+	QuirkZeroTS1
+	QuirkNonZeroTS2
+	QuirkOptTrailing
+	QuirkExcessWS
+	QuirkBadOpt
+)
+
+var quirkNames = []struct {
+	name string
+	q    Quirks
+}{
+	{"df", QuirkDF},
+	{"id+", QuirkNonZeroID},
+	{"id-", QuirkZeroID},
+	{"ecn", QuirkECN},
+	{"0+", QuirkNonZeroReserved},
+	{"flow", QuirkFlow},
+	{"seq-", QuirkZeroSeq},
+	{"ack+", QuirkNonZeroAck},
+	{"ack-", QuirkZeroAck},
+	{"uptr+", QuirkNonZeroURG},
+	{"urgf+", QuirkURG},
+	{"pushf+", QuirkPush},
+	{"ts1-", QuirkZeroTS1},
+	{"ts2+", QuirkNonZeroTS2},
+	{"opt+", QuirkOptTrailing},
+	{"exws", QuirkExcessWS},
+	{"bad", QuirkBadOpt},
+}
+
+func (q Quirks) String() string {
+	var b strings.Builder
+	for _, n := range quirkNames {
+		if q&n.q == 0 {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(n.name)
+	}
+	return b.String()
+}
+
+func ParseQuirks(s string) (Quirks, error) {
+	var q Quirks
+	if strings.TrimSpace(s) == "" {
+		return 0, nil
+	}
+	for _, f := range strings.Split(s, ",") {
+		f = strings.TrimSpace(f)
+		found := false
+		for _, n := range quirkNames {
+			if n.name == f {
+				q |= n.q
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("unknown quirk %q", f)
+		}
+	}
+	return q, nil
+}
+
+// Signature is a compiled tcp:request or tcp:response signature laid out as
+// ver:ittl:olen:mss:wsize,scale:olayout:quirks:pclass.
+type Signature struct {
+	Raw     string
+	Version int
+	TTL     int
+	BadTTL  bool
+	OLen    int
+	MSS     int
+	WinType WinType
+	Win     int
+	Scale   int
+	Layout  []string
+	Quirks  Quirks
+	PClass  int
+}
+
+func ParseSignature(s string) (Signature, error) {
+	sig := Signature{Raw: s}
+	parts := strings.Split(s, ":")
+	if len(parts) != 8 {
+		return sig, fmt.Errorf("want 8 fields, got %d", len(parts))
+	}
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	switch parts[0] {
+	case "4":
+		sig.Version = 4
+	case "6":
+		sig.Version = 6
+	case "*":
+		sig.Version = Any
+	default:
+		return sig, fmt.Errorf("bad ip version %q", parts[0])
+	}
+	if err := parseTTL(parts[1], &sig); err != nil {
+		return sig, err
+	}
+	olen, err := strconv.Atoi(parts[2])
+	if err != nil || olen < 0 || olen > 255 {
+		return sig, fmt.Errorf("bad ip option length %q", parts[2])
+	}
+	sig.OLen = olen
+	if sig.MSS, err = parseWild(parts[3], 65535); err != nil {
+		return sig, fmt.Errorf("bad mss %q", parts[3])
+	}
+	win, scale, ok := strings.Cut(parts[4], ",")
+	if !ok {
+		return sig, fmt.Errorf("missing window scale in %q", parts[4])
+	}
+	if err := parseWin(win, &sig); err != nil {
+		return sig, err
+	}
+	if sig.Scale, err = parseWild(scale, 255); err != nil {
+		return sig, fmt.Errorf("bad window scale %q", scale)
+	}
+	if sig.Layout, err = parseLayout(parts[5]); err != nil {
+		return sig, err
+	}
+	if sig.Quirks, err = ParseQuirks(parts[6]); err != nil {
+		return sig, err
+	}
+	switch parts[7] {
+	case "*":
+		sig.PClass = Any
+	case "0":
+		sig.PClass = 0
+	case "+":
+		sig.PClass = 1
+	default:
+		return sig, fmt.Errorf("bad payload class %q", parts[7])
+	}
+	return sig, nil
+}
+
+func parseTTL(s string, sig *Signature) error {
+	if n, ok := strings.CutSuffix(s, "-"); ok {
+		ttl, err := strconv.Atoi(n)
+		if err != nil || ttl < 1 || ttl > 255 {
+			return fmt.Errorf("bad ttl %q", s)
+		}
+		sig.TTL = ttl
+		sig.BadTTL = true
+		return nil
+	}
+	base, dist, hasDist := strings.Cut(s, "+")
+	ttl, err := strconv.Atoi(base)
+	if err != nil || ttl < 1 || ttl > 255 {
+		return fmt.Errorf("bad ttl %q", s)
+	}
+	if hasDist && dist == "?" {
+		// raw signatures print "ttl+?" when the initial TTL was guessed
+		ttl = guessTTL(ttl)
+	} else if hasDist {
+		d, err := strconv.Atoi(dist)
+		if err != nil || d < 0 || ttl+d > 255 {
+			return fmt.Errorf("bad ttl %q", s)
+		}
+		ttl += d
+	}
+	sig.TTL = ttl
+	return nil
+}
+
+func parseWin(s string, sig *Signature) error {
+	var err error
+	switch {
+	case s == "*":
+		sig.WinType = WinAny
+		return nil
+	case strings.HasPrefix(s, "mss*"):
+		sig.WinType = WinMSS
+		sig.Win, err = strconv.Atoi(s[4:])
+	case strings.HasPrefix(s, "mtu*"):
+		sig.WinType = WinMTU
+		sig.Win, err = strconv.Atoi(s[4:])
+	case strings.HasPrefix(s, "%"):
+		sig.WinType = WinMod
+		sig.Win, err = strconv.Atoi(s[1:])
+		if err == nil && sig.Win == 0 {
+			err = fmt.Errorf("zero modulus")
+		}
+	default:
+		sig.WinType = WinValue
+		sig.Win, err = strconv.Atoi(s)
+	}
+	if err != nil || sig.Win < 0 || sig.Win > 65535 {
+		return fmt.Errorf("bad window size %q", s)
+	}
+	return nil
+}
+
+func parseWild(s string, max int) (int, error) {
+	if s == "*" {
+		return Any, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 || n > max {
+		return 0, fmt.Errorf("out of range")
+	}
+	return n, nil
+}
+
+func parseLayout(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	out := strings.Split(strings.ToLower(s), ",")
+	for i, o := range out {
+		o = strings.TrimSpace(o)
+		out[i] = o
+		switch {
+		case o == "nop", o == "mss", o == "ws", o == "sok", o == "sack", o == "ts":
+		case strings.HasPrefix(o, "eol+"):
+			if _, err := strconv.ParseUint(o[4:], 10, 8); err != nil {
+				return nil, fmt.Errorf("bad option %q", o)
+			}
+		case strings.HasPrefix(o, "?"):
+			if _, err := strconv.ParseUint(o[1:], 10, 8); err != nil {
+				return nil, fmt.Errorf("bad option %q", o)
+			}
+		default:
+			return nil, fmt.Errorf("unknown option %q", o)
+		}
+	}
+	return out, nil
+}
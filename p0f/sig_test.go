@@ -0,0 +1,62 @@
+package p0f
+
+import "testing"
+
+func TestParseSignature(t *testing.T) {
+	sig, err := ParseSignature("4:64+2:0:1460:mss*20,7:mss,sok,ts,nop,ws:df,id+:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig.Version != 4 || sig.TTL != 66 || sig.BadTTL || sig.OLen != 0 || sig.MSS != 1460 {
+		t.Fatalf("bad header fields: %+v", sig)
+	}
+	if sig.WinType != WinMSS || sig.Win != 20 || sig.Scale != 7 {
+		t.Fatalf("bad window fields: %+v", sig)
+	}
+	if len(sig.Layout) != 5 || sig.Layout[4] != "ws" {
+		t.Fatalf("bad layout: %v", sig.Layout)
+	}
+	if sig.Quirks != QuirkDF|QuirkNonZeroID || sig.Quirks.String() != "df,id+" {
+		t.Fatalf("bad quirks: %v", sig.Quirks)
+	}
+	if sig.PClass != 0 {
+		t.Fatalf("bad pclass: %d", sig.PClass)
+	}
+
+	sig, err = ParseSignature("*:64-:0:*:%8192,*:mss,nop,eol+3::+")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig.Version != Any || sig.TTL != 64 || !sig.BadTTL || sig.MSS != Any || sig.Scale != Any || sig.PClass != 1 {
+		t.Fatalf("bad wildcard fields: %+v", sig)
+	}
+	if sig.WinType != WinMod || sig.Win != 8192 || sig.Layout[2] != "eol+3" || sig.Quirks != 0 {
+		t.Fatalf("bad fields: %+v", sig)
+	}
+
+	for _, bad := range []string{
+		"*:64:0:*:mss*20,7:mss,sok,ts,nop,ws:df,id+",
+		"5:64:0:*:mss*20,7:mss:df:0",
+		"*:64:0:*:mss*20:mss:df:0",
+		"*:64:0:*:mss*20,7:mss,foo:df:0",
+		"*:64:0:*:mss*20,7:mss:df,bogus:0",
+		"*:64:0:*:%0,7:mss:df:0",
+	} {
+		if _, err := ParseSignature(bad); err == nil {
+			t.Errorf("%q: expected error", bad)
+		}
+	}
+}
+
+func TestDataSignaturesParse(t *testing.T) {
+	for _, e := range Data.Entries {
+		if e.Section != "tcp:request" && e.Section != "tcp:response" {
+			continue
+		}
+		for _, s := range e.Sig {
+			if _, err := ParseSignature(s); err != nil {
+				t.Errorf("%s %q: %v", e.Label, s, err)
+			}
+		}
+	}
+}
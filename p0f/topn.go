@@ -0,0 +1,109 @@
+package p0f
+
+import "math"
+
+// DetectTopN returns up to n tcp:request labels for a SYN, best first, each
+// with a Confidence. The first one is what Detect reports, "Unknown" when
+// nothing matches.
+func DetectTopN(m PacketMeta, n int) []Result {
+	return Default().DetectTopN(m, n)
+}
+
+// DetectServerTopN is DetectTopN for a SYN+ACK and the tcp:response
+// signatures.
+func DetectServerTopN(m PacketMeta, n int) []Result {
+	return Default().DetectServerTopN(m, n)
+}
+
+// DetectTopN returns up to n tcp:request labels for a SYN, best first, each
+// with a Confidence. The first one is what Detect reports, "Unknown" when
+// nothing matches.
+func (d *Detector) DetectTopN(m PacketMeta, n int) []Result {
+	return topN(d.c.tcpRequest, m, d.opts.Fuzzy, &d.profile, n)
+}
+
+// DetectServerTopN is DetectTopN for a SYN+ACK and the tcp:response
+// signatures.
+func (d *Detector) DetectServerTopN(m PacketMeta, n int) []Result {
+	return topN(d.c.tcpResponse, m, d.opts.Fuzzy, &d.profile, n)
+}
+
+// Alternative is a runner-up label of DetectTopN in the form the capture
+// binaries print it.
+type Alternative struct {
+	Label      string    `json:"label"`
+	Sig        string    `json:"sig"`
+	Score      float64   `json:"score"`
+	Match      MatchKind `json:"match"`
+	Confidence float64   `json:"confidence"`
+}
+
+// Alternatives converts DetectTopN results, nil when there are none.
+func Alternatives(rs []Result) []Alternative {
+	var out []Alternative
+	for _, r := range rs {
+		out = append(out, Alternative{Label: r.Label, Sig: r.Sig, Score: r.Score, Match: r.Match, Confidence: r.Confidence})
+	}
+	return out
+}
+
+// confidenceSharpness scales score margins before they are shared out: a
+// one-point lead weighs e^confidenceSharpness times as much.
+const confidenceSharpness = 2
+
+// fuzzyConfidence scales the confidence of fuzzy matches, which p0f only
+// reports when nothing matches exactly.
+const fuzzyConfidence = 0.5
+
+// confidenceLabels is how many of the best labels share out confidence;
+// labels further down would add next to nothing, and leaving them out lets
+// matchN skip the layouts that cannot reach them.
+const confidenceLabels = 8
+
+// topN keeps the best signature of each label, in MatchKind precedence and
+// then score order. A label's confidence is its score as a fraction of the
+// best possible score, times its share of exp(confidenceSharpness*score)
+// among the confidenceLabels best labels, so exact ties split evenly; a
+// label outranked by kind counts with at most the first label's score, and
+// fuzzy matches are scaled by fuzzyConfidence.
+func topN(ix *sigIndex, m PacketMeta, fuzzy bool, p *ScoringProfile, n int) []Result {
+	if n < 1 {
+		return nil
+	}
+	var buf [confidenceLabels]ranked
+	best := buf[:]
+	if n > len(best) {
+		best = make([]ranked, n)
+	}
+	k := 0
+	if ix != nil {
+		k = ix.matchN(m, fuzzy, p, best)
+	}
+	if k == 0 {
+		return []Result{{Label: "Unknown", Distance: guessDist(m.TTL)}}
+	}
+	best = best[:k]
+	top := best[0].score
+	sum := 0.0
+	for _, r := range best[:min(k, confidenceLabels)] {
+		sum += math.Exp(confidenceSharpness * (min(r.score, top) - top))
+	}
+	maxScore := p.LayoutExact + p.maxField()
+	if len(best) > n {
+		best = best[:n]
+	}
+	out := make([]Result, len(best))
+	for i, r := range best {
+		out[i] = newResult(r.cs, r.score, m)
+		score := min(r.score, top)
+		fit := 1.0
+		if maxScore > 0 {
+			fit = math.Max(0, math.Min(1, score/maxScore))
+		}
+		if r.kind == MatchFuzzy {
+			fit *= fuzzyConfidence
+		}
+		out[i].Confidence = fit * math.Exp(confidenceSharpness*(score-top)) / sum
+	}
+	return out
+}
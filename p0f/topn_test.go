@@ -0,0 +1,108 @@
+package p0f
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestDetectTopN(t *testing.T) {
+	linux := PacketMeta{TTL: 61, Win: 29200, MSS: 1460, WScale: 7, Options: []string{"mss", "sok", "ts", "nop", "ws"}, Quirks: QuirkDF | QuirkNonZeroID}
+	top := DetectTopN(linux, 3)
+	if len(top) != 3 || top[0].Label != "s:unix:Linux:3.11 and newer" || top[0].Confidence < 0.5 {
+		t.Fatalf("got %+v", top)
+	}
+	labels := map[string]bool{}
+	for i, r := range top {
+		if labels[r.Label] {
+			t.Fatalf("label %q listed twice", r.Label)
+		}
+		labels[r.Label] = true
+		if i == 0 {
+			continue
+		}
+		if prev := top[i-1]; r.Match > prev.Match || r.Match == prev.Match && r.Score > prev.Score || r.Confidence > prev.Confidence {
+			t.Fatalf("out of order: %+v", top)
+		}
+	}
+
+	// Two labels with identical signatures split the confidence evenly.
+	db, err := LoadDB(strings.NewReader("classes = unix\n[tcp:request]\nlabel = s:unix:A:1\nsig = *:64:0:*:mss*20,7:mss,sok,ts,nop,ws:df,id+:0\nlabel = s:unix:B:1\nsig = *:64:0:*:mss*20,7:mss,sok,ts,nop,ws:df,id+:0\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	top = NewDetector(db, DefaultOptions()).DetectTopN(linux, 5)
+	if len(top) != 2 || top[0].Label != "s:unix:A:1" || top[0].Confidence != 0.5 || top[1].Confidence != 0.5 {
+		t.Fatalf("tie: %+v", top)
+	}
+
+	// An exact specific match outranks a higher scoring generic one, and a
+	// fuzzy match is at most half confident.
+	db, err = LoadDB(strings.NewReader("classes = unix\n[tcp:request]\nlabel = g:unix:Gen:1\nsig = *:64:0:*:mss*20,7:mss,sok,ts,nop,ws:df,id+:0\nlabel = s:unix:Spec:1\nsig = *:64:0:*:*,*:mss,sok,ts,nop,ws:df,id+:0\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDetector(db, DefaultOptions())
+	top = d.DetectTopN(linux, 2)
+	if len(top) != 2 || top[0].Label != "s:unix:Spec:1" || top[0].Match != MatchExact || top[1].Match != MatchGeneric || top[0].Score >= top[1].Score || top[0].Confidence < top[1].Confidence {
+		t.Fatalf("precedence: %+v", top)
+	}
+	noID := linux
+	noID.Quirks = QuirkDF
+	if top := d.DetectTopN(noID, 1); top[0].Label != "g:unix:Gen:1" || top[0].Match != MatchFuzzy || top[0].Confidence > 0.5 {
+		t.Fatalf("fuzzy: %+v", top)
+	}
+
+	if top := DetectTopN(PacketMeta{TTL: 64, Options: []string{"mss"}, Quirks: QuirkZeroSeq | QuirkNonZeroURG | QuirkPush}, 2); len(top) != 1 || top[0].Label != "Unknown" || top[0].Confidence != 0 {
+		t.Fatalf("no match: %+v", top)
+	}
+
+	if alts := Alternatives(top[1:]); len(alts) != 1 || alts[0].Label != top[1].Label || alts[0].Match != top[1].Match || alts[0].Confidence != top[1].Confidence {
+		t.Fatalf("Alternatives = %+v", alts)
+	}
+	if Alternatives(nil) != nil {
+		t.Fatal("Alternatives(nil) should be nil so omitempty drops it")
+	}
+
+	rng := rand.New(rand.NewSource(3))
+	names := []string{"mss", "ws", "sok", "ts", "nop", "sack"}
+	for i := 0; i < 1000; i++ {
+		m := PacketMeta{
+			TTL:    []int{50, 64, 128, 255}[rng.Intn(4)],
+			Win:    []uint16{8192, 29200, 65535}[rng.Intn(3)],
+			MSS:    []uint16{0, 1460}[rng.Intn(2)],
+			WScale: rng.Intn(9),
+			Quirks: []Quirks{0, QuirkDF | QuirkNonZeroID}[rng.Intn(2)],
+		}
+		for j := rng.Intn(6); j > 0; j-- {
+			m.Options = append(m.Options, names[rng.Intn(len(names))])
+		}
+		r := Detect(m)
+		top := DetectTopN(m, 1)[0]
+		if top.Label != r.Label || top.Score != r.Score || top.Confidence < 0 || top.Confidence > 1 {
+			t.Fatalf("%+v: DetectTopN %+v, Detect %+v", m, top, r)
+		}
+		// The pruned scan lists the same labels as ranking every signature.
+		var want []ranked
+		seen := map[string]bool{}
+		d := Default()
+		for _, c := range rank(d.c.tcpRequest, m, d.opts.Fuzzy, &d.profile, false) {
+			if !seen[c.cs.label] && len(want) < confidenceLabels {
+				seen[c.cs.label] = true
+				want = append(want, c)
+			}
+		}
+		got := DetectTopN(m, confidenceLabels)
+		if len(want) == 0 {
+			want = append(want, ranked{cs: &compiledSig{label: "Unknown"}})
+		}
+		if len(got) != len(want) {
+			t.Fatalf("%+v: DetectTopN %+v, want %d labels", m, got, len(want))
+		}
+		for j := range got {
+			if got[j].Label != want[j].cs.label || got[j].Score != want[j].score {
+				t.Fatalf("%+v: DetectTopN %+v, #%d want %s %v", m, got, j, want[j].cs.label, want[j].score)
+			}
+		}
+	}
+}